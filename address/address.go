@@ -0,0 +1,100 @@
+package address
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+	"github.com/kiyuu10/common-lib-go/gconsts"
+	"github.com/kiyuu10/common-lib-go/gmeta"
+)
+
+var (
+	ErrInvalidFormat         = errors.New("address: invalid format")
+	ErrInvalidChecksum       = errors.New("address: invalid checksum")
+	ErrInvalidLength         = errors.New("address: invalid length")
+	ErrWrongNetwork          = errors.New("address: wrong network")
+	ErrInvalidDestinationTag = errors.New("address: invalid destination tag")
+	ErrUnsupportedNetwork    = errors.New("address: unsupported network")
+)
+
+// Address is a normalized address, DestinationTag is only set for Ripple.
+type Address struct {
+	Network        gmeta.BlockchainNetwork
+	Value          string
+	DestinationTag *uint32
+}
+
+func (a Address) String() string {
+	if a.DestinationTag == nil {
+		return a.Value
+	}
+	return a.Value + rippleTagSeparator + strconv.FormatUint(uint64(*a.DestinationTag), 10)
+}
+
+// Parse validates and normalizes the address, the error is gconsts.ErrorAddress wrapping one of the Err* sentinels.
+func Parse(network gmeta.BlockchainNetwork, input string) (Address, error) {
+	address, err := parse(network, strings.TrimSpace(input))
+	if err != nil {
+		return Address{}, gconsts.ErrorAddress.Wrap(
+			erroy.Wrap(err).
+				WithField("network", network).
+				WithField("address", input),
+		)
+	}
+	return address, nil
+}
+
+func Validate(network gmeta.BlockchainNetwork, input string) error {
+	_, err := Parse(network, input)
+	return err
+}
+
+// Normalize returns the canonical form:
+// EIP-55 for EVM, lowercase bech32, cashaddr with prefix for BCH and raw `<workchain>:<hex>` for TON.
+func Normalize(network gmeta.BlockchainNetwork, input string) (string, error) {
+	address, err := Parse(network, input)
+	if err != nil {
+		return "", err
+	}
+	return address.String(), nil
+}
+
+func parse(network gmeta.BlockchainNetwork, input string) (address Address, err error) {
+	address.Network = network
+	isTestnet := network.IsTestnet()
+	switch network.Type() {
+	case gconsts.BlockchainTypeUtxoBitcoin:
+		address.Value, err = normalizeUtxo(pickUtxoParams(isTestnet, vUtxoBitcoinParams, vUtxoBitcoinTest), input)
+	case gconsts.BlockchainTypeUtxoBitcoinCash:
+		address.Value, err = normalizeUtxo(pickUtxoParams(isTestnet, vUtxoBitcoinCashParams, vUtxoBitcoinCashTest), input)
+	case gconsts.BlockchainTypeUtxoLitecoin:
+		address.Value, err = normalizeUtxo(pickUtxoParams(isTestnet, vUtxoLitecoinParams, vUtxoLitecoinTest), input)
+	case gconsts.BlockchainTypeUtxoDogecoin:
+		address.Value, err = normalizeUtxo(pickUtxoParams(isTestnet, vUtxoDogecoinParams, vUtxoDogecoinTest), input)
+	case gconsts.BlockchainTypeEVM:
+		address.Value, err = normalizeEvm(input)
+	case gconsts.BlockchainTypeTron:
+		address.Value, err = normalizeTron(input)
+	case gconsts.BlockchainTypeRipple:
+		var value string
+		if value, address.DestinationTag, err = splitRippleTag(input); err == nil {
+			address.Value, err = normalizeRipple(value)
+		}
+	case gconsts.BlockchainTypeSolana:
+		address.Value, err = normalizeSolana(input)
+	case gconsts.BlockchainTypeTON:
+		address.Value, err = normalizeTon(input, isTestnet)
+	default:
+		err = ErrUnsupportedNetwork
+	}
+	return
+}
+
+func pickUtxoParams(isTestnet bool, mainnet tUtxoParams, testnet tUtxoParams) tUtxoParams {
+	if isTestnet {
+		return testnet
+	}
+	return mainnet
+}
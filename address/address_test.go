@@ -0,0 +1,158 @@
+package address
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kiyuu10/common-lib-go/gconsts"
+	"github.com/kiyuu10/common-lib-go/gmeta"
+	"github.com/kiyuu10/common-lib-go/types"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		network gmeta.BlockchainNetwork
+		input   string
+		want    string
+	}{
+		// EIP-55
+		{"eip55 checksummed", gconsts.BlockchainNetworkEthereum, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{"eip55 lowercase", gconsts.BlockchainNetworkEthereum, "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359", "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"},
+		{"eip55 uppercase", gconsts.BlockchainNetworkBinanceSmartChain, "0xDBF03B407C01E7CD3CBEA99509D93F8DDDC8C6FB", "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"},
+		{"eip55 testnet", gconsts.BlockchainNetworkPolygonTestnet, "0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb", "0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb"},
+
+		// BIP-173 and BIP-350
+		{"bip350 p2wpkh", gconsts.BlockchainNetworkBitcoin, "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+		{"bip350 p2wsh testnet", gconsts.BlockchainNetworkBitcoinTestnet, "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7"},
+		{"bip350 v1", gconsts.BlockchainNetworkBitcoin, "bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7kt5nd6y", "bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7kt5nd6y"},
+		{"bip350 v16", gconsts.BlockchainNetworkBitcoin, "BC1SW50QGDZ25J", "bc1sw50qgdz25j"},
+		{"bip350 v2", gconsts.BlockchainNetworkBitcoin, "bc1zw508d6qejxtdg4y5r3zarvaryvaxxpcs", ""},
+		{"bip350 v0 testnet", gconsts.BlockchainNetworkBitcoinTestnet, "tb1qqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesrxh6hy", "tb1qqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesrxh6hy"},
+		{"bip350 v1 testnet", gconsts.BlockchainNetworkBitcoinTestnet, "tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c", "tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c"},
+		{"bip350 taproot", gconsts.BlockchainNetworkBitcoin, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"},
+
+		// Base58check
+		{"btc p2pkh", gconsts.BlockchainNetworkBitcoin, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+		{"btc p2sh", gconsts.BlockchainNetworkBitcoin, "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"},
+		{"ltc p2pkh", gconsts.BlockchainNetworkLitecoin, "LaMT348PWRnrqeeWArpwQPbuanpXDZGEUz", "LaMT348PWRnrqeeWArpwQPbuanpXDZGEUz"},
+		{"doge p2pkh", gconsts.BlockchainNetworkDogecoin, "DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L", "DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L"},
+
+		// Cashaddr
+		{"bch cashaddr", gconsts.BlockchainNetworkBitcoinCash, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+		{"bch cashaddr without prefix", gconsts.BlockchainNetworkBitcoinCash, "qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+		{"bch legacy", gconsts.BlockchainNetworkBitcoinCash, "1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu", "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+
+		// Other chains
+		{"tron", gconsts.BlockchainNetworkTron, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"},
+		{"tron hex", gconsts.BlockchainNetworkTron, "41a614f803b6fd780986a42c78ec9c7f77e6ded13c", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"},
+		{"ripple", gconsts.BlockchainNetworkRipple, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+		{"ripple destination tag", gconsts.BlockchainNetworkRipple, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh?dt=12345", "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh?dt=12345"},
+		{"solana", gconsts.BlockchainNetworkSolana, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"},
+		{"ton friendly", gconsts.BlockchainNetworkTon, "EQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8xqB2N", "0:83dfd552e63729b472fcbcc8c45ebcc6691702558b68ec7527e1ba403a0f31a8"},
+		{"ton raw", gconsts.BlockchainNetworkTon, "0:83DFD552E63729B472FCBCC8C45EBCC6691702558B68EC7527E1BA403A0F31A8", "0:83dfd552e63729b472fcbcc8c45ebcc6691702558b68ec7527e1ba403a0f31a8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.network, tt.input)
+			if tt.want == "" {
+				tt.want = tt.input
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Normalize(%s, %q) = %q, %v, want %q", tt.network, tt.input, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		network gmeta.BlockchainNetwork
+		input   string
+		wantErr error
+	}{
+		// EIP-55
+		{"eip55 wrong checksum", gconsts.BlockchainNetworkEthereum, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", ErrInvalidChecksum},
+		{"evm without prefix", gconsts.BlockchainNetworkEthereum, "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ErrInvalidFormat},
+		{"evm short", gconsts.BlockchainNetworkEthereum, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", ErrInvalidFormat},
+
+		// BIP-350
+		{"bip350 bech32 for v1", gconsts.BlockchainNetworkBitcoin, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd", ErrInvalidChecksum},
+		{"bip173 bech32 for v2", gconsts.BlockchainNetworkBitcoin, "bc1zw508d6qejxtdg4y5r3zarvaryvg6kdaj", ErrInvalidChecksum},
+		{"bip350 bech32m for v0", gconsts.BlockchainNetworkBitcoin, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh", ErrInvalidChecksum},
+		{"bip350 invalid version", gconsts.BlockchainNetworkBitcoin, "BC130XLXVLHEMJA6C4DQV22UAPCTQUPFHLXM9H8Z3K2E72Q4K9HCZ7VQ7ZWS8R", ErrInvalidFormat},
+		{"bip350 program too short", gconsts.BlockchainNetworkBitcoin, "bc1pw5dgrnzv", ErrInvalidLength},
+		{"bip350 v0 program length", gconsts.BlockchainNetworkBitcoin, "BC1QR508D6QEJXTDG4Y5R3ZARVARYV98GJ9P", ErrInvalidLength},
+		{"bip350 mixed case", gconsts.BlockchainNetworkBitcoinTestnet, "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3Q0sl5k7", ErrInvalidFormat},
+		{"bip350 padding", gconsts.BlockchainNetworkBitcoinTestnet, "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3pjxtptv", ErrInvalidFormat},
+		{"bip350 empty data", gconsts.BlockchainNetworkBitcoin, "bc1gmk9yu", ErrInvalidFormat},
+		{"segwit wrong network", gconsts.BlockchainNetworkBitcoin, "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", nil},
+		{"segwit wrong hrp checksum", gconsts.BlockchainNetworkBitcoin, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", ErrInvalidChecksum},
+
+		// Base58check
+		{"btc wrong checksum", gconsts.BlockchainNetworkBitcoin, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3", ErrInvalidChecksum},
+		{"btc on testnet", gconsts.BlockchainNetworkBitcoinTestnet, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", ErrWrongNetwork},
+		{"btc on dogecoin", gconsts.BlockchainNetworkDogecoin, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", ErrWrongNetwork},
+		{"bch wrong prefix", gconsts.BlockchainNetworkBitcoinCashTestnet, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", nil},
+
+		// Other chains
+		{"tron evm address", gconsts.BlockchainNetworkTron, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ErrInvalidFormat},
+		{"tron wrong checksum", gconsts.BlockchainNetworkTron, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", ErrInvalidChecksum},
+		{"ripple bitcoin alphabet", gconsts.BlockchainNetworkRipple, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", nil},
+		{"ripple destination tag", gconsts.BlockchainNetworkRipple, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh?dt=4294967296", ErrInvalidDestinationTag},
+		{"solana short key", gconsts.BlockchainNetworkSolana, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwy", ErrInvalidLength},
+		{"ton wrong checksum", gconsts.BlockchainNetworkTon, "EQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8xqB2M", ErrInvalidChecksum},
+		{"ton raw short", gconsts.BlockchainNetworkTon, "0:83dfd552", ErrInvalidLength},
+		{"unsupported network", gconsts.BlockchainNetworkFiat, "anything", ErrUnsupportedNetwork},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.network, tt.input)
+			if !errors.Is(err, gconsts.ErrorAddress) {
+				t.Fatalf("Validate(%s, %q) = %v, want ErrorAddress", tt.network, tt.input, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate(%s, %q) = %v, want %v", tt.network, tt.input, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTronHex(t *testing.T) {
+	hexAddress, err := TronToHex("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t")
+	if err != nil || hexAddress != "41a614f803b6fd780986a42c78ec9c7f77e6ded13c" {
+		t.Fatalf("TronToHex = %s, %v", hexAddress, err)
+	}
+	address, err := TronFromHex("0xa614f803b6fd780986a42c78ec9c7f77e6ded13c")
+	if err != nil || address != "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t" {
+		t.Fatalf("TronFromHex = %s, %v", address, err)
+	}
+}
+
+func TestInstallValidator(t *testing.T) {
+	// DefaultValidator created before InstallValidator must get the tag too.
+	types.DefaultValidator.Get()
+	InstallValidator()
+	InstallValidator()
+	type tWithdrawal struct {
+		Network gmeta.BlockchainNetwork `validate:"required"`
+		Address string                  `validate:"required,coin_address=Network"`
+	}
+	tests := []struct {
+		name       string
+		withdrawal tWithdrawal
+		wantErr    bool
+	}{
+		{"valid", tWithdrawal{gconsts.BlockchainNetworkEthereum, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}, false},
+		{"wrong checksum", tWithdrawal{gconsts.BlockchainNetworkEthereum, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD"}, true},
+		{"wrong network", tWithdrawal{gconsts.BlockchainNetworkTron, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := types.ValidateStruct(tt.withdrawal); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateStruct(%+v) = %v, wantErr %v", tt.withdrawal, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package address
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+)
+
+const (
+	cBase58AlphabetBitcoin = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	cBase58AlphabetRipple  = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+
+	base58ChecksumLength = 4
+)
+
+type tBase58Alphabet struct {
+	chars   string
+	indexes [256]int
+}
+
+var (
+	vBase58Bitcoin = newBase58Alphabet(cBase58AlphabetBitcoin)
+	vBase58Ripple  = newBase58Alphabet(cBase58AlphabetRipple)
+
+	errBase58Character = errors.New("invalid base58 character")
+)
+
+func newBase58Alphabet(chars string) *tBase58Alphabet {
+	alphabet := &tBase58Alphabet{chars: chars}
+	for i := range alphabet.indexes {
+		alphabet.indexes[i] = -1
+	}
+	for i := 0; i < len(chars); i++ {
+		alphabet.indexes[chars[i]] = i
+	}
+	return alphabet
+}
+
+func (a *tBase58Alphabet) decode(input string) ([]byte, error) {
+	var (
+		zeros   int
+		decoded = make([]byte, 0, len(input))
+	)
+	for zeros < len(input) && input[zeros] == a.chars[0] {
+		zeros++
+	}
+	for i := zeros; i < len(input); i++ {
+		carry := a.indexes[input[i]]
+		if carry < 0 {
+			return nil, errBase58Character
+		}
+		for j := range decoded {
+			carry += int(decoded[j]) * 58
+			decoded[j] = byte(carry)
+			carry >>= 8
+		}
+		for ; carry > 0; carry >>= 8 {
+			decoded = append(decoded, byte(carry))
+		}
+	}
+	result := make([]byte, zeros+len(decoded))
+	for i, b := range decoded {
+		result[len(result)-1-i] = b
+	}
+	return result, nil
+}
+
+func (a *tBase58Alphabet) encode(input []byte) string {
+	var (
+		zeros   int
+		encoded = make([]byte, 0, len(input)*138/100+1)
+	)
+	for zeros < len(input) && input[zeros] == 0 {
+		zeros++
+	}
+	for _, b := range input[zeros:] {
+		carry := int(b)
+		for j := range encoded {
+			carry += int(encoded[j]) << 8
+			encoded[j] = byte(carry % 58)
+			carry /= 58
+		}
+		for ; carry > 0; carry /= 58 {
+			encoded = append(encoded, byte(carry%58))
+		}
+	}
+	result := make([]byte, zeros+len(encoded))
+	for i := 0; i < zeros; i++ {
+		result[i] = a.chars[0]
+	}
+	for i, digit := range encoded {
+		result[len(result)-1-i] = a.chars[digit]
+	}
+	return string(result)
+}
+
+func base58Checksum(payload []byte) []byte {
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	return second[:base58ChecksumLength]
+}
+
+// decodeCheck returns the payload without the double SHA-256 checksum.
+func (a *tBase58Alphabet) decodeCheck(input string) ([]byte, error) {
+	decoded, err := a.decode(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) <= base58ChecksumLength {
+		return nil, ErrInvalidLength
+	}
+	payload := decoded[:len(decoded)-base58ChecksumLength]
+	if !bytes.Equal(base58Checksum(payload), decoded[len(payload):]) {
+		return nil, ErrInvalidChecksum
+	}
+	return payload, nil
+}
+
+func (a *tBase58Alphabet) encodeCheck(payload []byte) string {
+	return a.encode(append(append([]byte(nil), payload...), base58Checksum(payload)...))
+}
@@ -0,0 +1,133 @@
+package address
+
+import (
+	"strings"
+)
+
+const (
+	cBech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	bech32ChecksumLength = 6
+	bech32MaxLength      = 90
+	bech32Const          = 1
+	bech32mConst         = 0x2bc830a3
+)
+
+var vBech32Generators = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+func bech32Polymod(values []byte) uint32 {
+	checksum := uint32(1)
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum&0x1ffffff)<<5 ^ uint32(value)
+		for i, generator := range vBech32Generators {
+			if (top>>i)&1 == 1 {
+				checksum ^= generator
+			}
+		}
+	}
+	return checksum
+}
+
+func bech32HrpExpand(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	return expanded
+}
+
+// charsetDecode maps characters to 5-bit values.
+func charsetDecode(input string) ([]byte, error) {
+	values := make([]byte, len(input))
+	for i := 0; i < len(input); i++ {
+		index := strings.IndexByte(cBech32Charset, input[i])
+		if index < 0 {
+			return nil, ErrInvalidFormat
+		}
+		values[i] = byte(index)
+	}
+	return values, nil
+}
+
+// bech32Decode returns the lowercase hrp, 5-bit data without checksum and the checksum constant.
+func bech32Decode(input string) (string, []byte, uint32, error) {
+	if len(input) > bech32MaxLength || (strings.ToLower(input) != input && strings.ToUpper(input) != input) {
+		return "", nil, 0, ErrInvalidFormat
+	}
+	input = strings.ToLower(input)
+	sepIdx := strings.LastIndexByte(input, '1')
+	if sepIdx < 1 || sepIdx+bech32ChecksumLength+1 > len(input) {
+		return "", nil, 0, ErrInvalidFormat
+	}
+	hrp := input[:sepIdx]
+	data, err := charsetDecode(input[sepIdx+1:])
+	if err != nil {
+		return "", nil, 0, err
+	}
+	checksumConst := bech32Polymod(append(bech32HrpExpand(hrp), data...))
+	if checksumConst != bech32Const && checksumConst != bech32mConst {
+		return "", nil, 0, ErrInvalidChecksum
+	}
+	return hrp, data[:len(data)-bech32ChecksumLength], checksumConst, nil
+}
+
+func convertBits(data []byte, fromBits uint, toBits uint, pad bool) ([]byte, error) {
+	var (
+		acc    uint32
+		bits   uint
+		result = make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+		maxV   = uint32(1)<<toBits - 1
+	)
+	for _, value := range data {
+		if uint32(value)>>fromBits != 0 {
+			return nil, ErrInvalidFormat
+		}
+		acc = acc<<fromBits | uint32(value)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, byte(acc>>bits&maxV))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			result = append(result, byte(acc<<(toBits-bits)&maxV))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxV != 0 {
+		return nil, ErrInvalidFormat
+	}
+	return result, nil
+}
+
+// decodeSegwit validates BIP-173 and BIP-350 rules of the witness version and program.
+func decodeSegwit(expectedHrp string, input string) error {
+	hrp, data, checksumConst, err := bech32Decode(input)
+	if err != nil {
+		return err
+	}
+	if hrp != expectedHrp {
+		return ErrWrongNetwork
+	}
+	if len(data) < 1 || data[0] > 16 {
+		return ErrInvalidFormat
+	}
+	program, err := convertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return err
+	}
+	version := data[0]
+	switch {
+	case len(program) < 2 || len(program) > 40:
+		return ErrInvalidLength
+	case version == 0 && len(program) != 20 && len(program) != 32:
+		return ErrInvalidLength
+	case version == 0 && checksumConst != bech32Const, version > 0 && checksumConst != bech32mConst:
+		return ErrInvalidChecksum
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package address
+
+import (
+	"strings"
+)
+
+const (
+	cashAddrChecksumLength = 8
+	cashAddrHashLength     = 20
+
+	cashAddrTypeP2PKH byte = 0
+	cashAddrTypeP2SH  byte = 1
+)
+
+var vCashAddrGenerators = [5]uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+
+func cashAddrPolymod(values []byte) uint64 {
+	checksum := uint64(1)
+	for _, value := range values {
+		top := checksum >> 35
+		checksum = (checksum&0x07ffffffff)<<5 ^ uint64(value)
+		for i, generator := range vCashAddrGenerators {
+			if (top>>i)&1 == 1 {
+				checksum ^= generator
+			}
+		}
+	}
+	return checksum ^ 1
+}
+
+func cashAddrPrefixExpand(prefix string) []byte {
+	expanded := make([]byte, 0, len(prefix)+1)
+	for i := 0; i < len(prefix); i++ {
+		expanded = append(expanded, prefix[i]&31)
+	}
+	return append(expanded, 0)
+}
+
+// decodeCashAddr accepts the address with or without the prefix and returns the address type and hash.
+func decodeCashAddr(expectedPrefix string, input string) (byte, []byte, error) {
+	if strings.ToLower(input) != input && strings.ToUpper(input) != input {
+		return 0, nil, ErrInvalidFormat
+	}
+	input = strings.ToLower(input)
+	if prefix, payload, ok := strings.Cut(input, ":"); ok {
+		if prefix != expectedPrefix {
+			return 0, nil, ErrWrongNetwork
+		}
+		input = payload
+	}
+	data, err := charsetDecode(input)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(data) <= cashAddrChecksumLength {
+		return 0, nil, ErrInvalidLength
+	}
+	if cashAddrPolymod(append(cashAddrPrefixExpand(expectedPrefix), data...)) != 0 {
+		return 0, nil, ErrInvalidChecksum
+	}
+	payload, err := convertBits(data[:len(data)-cashAddrChecksumLength], 5, 8, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	// Only 160-bit hashes are used, their size bits of the version byte are zero.
+	if len(payload) != cashAddrHashLength+1 || payload[0]&0x07 != 0 {
+		return 0, nil, ErrInvalidLength
+	}
+	addrType := payload[0] >> 3
+	if addrType != cashAddrTypeP2PKH && addrType != cashAddrTypeP2SH {
+		return 0, nil, ErrInvalidFormat
+	}
+	return addrType, payload[1:], nil
+}
+
+func encodeCashAddr(prefix string, addrType byte, hash []byte) string {
+	data, _ := convertBits(append([]byte{addrType << 3}, hash...), 8, 5, true)
+	polymod := cashAddrPolymod(append(append(cashAddrPrefixExpand(prefix), data...), make([]byte, cashAddrChecksumLength)...))
+	for i := 0; i < cashAddrChecksumLength; i++ {
+		data = append(data, byte(polymod>>(5*(cashAddrChecksumLength-1-i))&31))
+	}
+	var sb strings.Builder
+	sb.WriteString(prefix + ":")
+	for _, value := range data {
+		sb.WriteByte(cBech32Charset[value])
+	}
+	return sb.String()
+}
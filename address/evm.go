@@ -0,0 +1,50 @@
+package address
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	evmPrefix     = "0x"
+	evmHexLength  = 40
+	evmHashLength = 20
+)
+
+// normalizeEvm returns the EIP-55 checksum form, mixed-case input must have a valid checksum.
+func normalizeEvm(input string) (string, error) {
+	if !strings.HasPrefix(input, evmPrefix) || len(input) != len(evmPrefix)+evmHexLength {
+		return "", ErrInvalidFormat
+	}
+	hexPart := input[len(evmPrefix):]
+	if _, err := hex.DecodeString(hexPart); err != nil {
+		return "", ErrInvalidFormat
+	}
+	checksummed := evmChecksum(hexPart)
+	isMixedCase := strings.ToLower(hexPart) != hexPart && strings.ToUpper(hexPart) != hexPart
+	if isMixedCase && checksummed != input {
+		return "", ErrInvalidChecksum
+	}
+	return checksummed, nil
+}
+
+func evmChecksum(hexPart string) string {
+	lowerHex := strings.ToLower(hexPart)
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write([]byte(lowerHex))
+	hash := hasher.Sum(nil)
+
+	checksummed := []byte(lowerHex)
+	for i, char := range checksummed {
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if char >= 'a' && nibble&0x0f >= 8 {
+			checksummed[i] = char - 'a' + 'A'
+		}
+	}
+	return evmPrefix + string(checksummed)
+}
@@ -0,0 +1,36 @@
+package address
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	rippleVersion      byte = 0x00
+	rippleTagSeparator      = "?dt="
+)
+
+// splitRippleTag splits `<address>?dt=<tag>`, the tag is a 32-bit unsigned integer.
+func splitRippleTag(input string) (string, *uint32, error) {
+	address, tagStr, ok := strings.Cut(input, rippleTagSeparator)
+	if !ok {
+		return input, nil, nil
+	}
+	tag, err := strconv.ParseUint(tagStr, 10, 32)
+	if err != nil {
+		return "", nil, ErrInvalidDestinationTag
+	}
+	tag32 := uint32(tag)
+	return address, &tag32, nil
+}
+
+func normalizeRipple(input string) (string, error) {
+	payload, err := vBase58Ripple.decodeCheck(input)
+	if err != nil {
+		return "", err
+	}
+	if len(payload) != evmHashLength+1 || payload[0] != rippleVersion {
+		return "", ErrInvalidLength
+	}
+	return input, nil
+}
@@ -0,0 +1,16 @@
+package address
+
+const (
+	solanaKeyLength = 32
+)
+
+func normalizeSolana(input string) (string, error) {
+	key, err := vBase58Bitcoin.decode(input)
+	if err != nil {
+		return "", err
+	}
+	if len(key) != solanaKeyLength {
+		return "", ErrInvalidLength
+	}
+	return input, nil
+}
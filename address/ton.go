@@ -0,0 +1,73 @@
+package address
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+const (
+	tonFriendlyLength    = 48
+	tonFriendlyRawLength = 36
+	tonHashLength        = 32
+
+	tonFlagBounceable    byte = 0x11
+	tonFlagNonBounceable byte = 0x51
+	tonFlagTestOnly      byte = 0x80
+)
+
+// normalizeTon returns the raw form `<workchain>:<hex>`, the user-friendly form is also accepted.
+func normalizeTon(input string, isTestnet bool) (string, error) {
+	if workchain, hash, ok := strings.Cut(input, ":"); ok {
+		workchainID, err := strconv.ParseInt(workchain, 10, 32)
+		if err != nil {
+			return "", ErrInvalidFormat
+		}
+		hashBytes, err := hex.DecodeString(hash)
+		if err != nil || len(hashBytes) != tonHashLength {
+			return "", ErrInvalidLength
+		}
+		return tonRawAddress(workchainID, hashBytes), nil
+	}
+
+	if len(input) != tonFriendlyLength {
+		return "", ErrInvalidLength
+	}
+	data, err := base64.RawURLEncoding.DecodeString(strings.NewReplacer("+", "-", "/", "_").Replace(input))
+	if err != nil || len(data) != tonFriendlyRawLength {
+		return "", ErrInvalidFormat
+	}
+	if tonCrc16(data[:34]) != binary.BigEndian.Uint16(data[34:]) {
+		return "", ErrInvalidChecksum
+	}
+	flag := data[0]
+	if flag&tonFlagTestOnly != 0 && !isTestnet {
+		return "", ErrWrongNetwork
+	}
+	if flag &^= tonFlagTestOnly; flag != tonFlagBounceable && flag != tonFlagNonBounceable {
+		return "", ErrInvalidFormat
+	}
+	return tonRawAddress(int64(int8(data[1])), data[2:34]), nil
+}
+
+func tonRawAddress(workchainID int64, hash []byte) string {
+	return strconv.FormatInt(workchainID, 10) + ":" + hex.EncodeToString(hash)
+}
+
+// tonCrc16 is CRC-16/XMODEM.
+func tonCrc16(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,59 @@
+package address
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	tronVersion    byte = 0x41
+	tronHexLength       = 2 * (evmHashLength + 1)
+	tronPrefixChar      = 'T'
+)
+
+// normalizeTron returns the base58 form, the hex form `41...` is also accepted.
+func normalizeTron(input string) (string, error) {
+	if len(input) == tronHexLength && input[0] != tronPrefixChar {
+		payload, err := hex.DecodeString(input)
+		if err != nil || payload[0] != tronVersion {
+			return "", ErrInvalidFormat
+		}
+		return vBase58Bitcoin.encodeCheck(payload), nil
+	}
+	if input == "" || input[0] != tronPrefixChar {
+		return "", ErrInvalidFormat
+	}
+	payload, err := vBase58Bitcoin.decodeCheck(input)
+	if err != nil {
+		return "", err
+	}
+	if len(payload) != evmHashLength+1 || payload[0] != tronVersion {
+		return "", ErrInvalidLength
+	}
+	return input, nil
+}
+
+// TronToHex converts a base58 address to the hex form starting with `41`.
+func TronToHex(address string) (string, error) {
+	if _, err := normalizeTron(address); err != nil {
+		return "", err
+	}
+	if len(address) == tronHexLength {
+		return strings.ToLower(address), nil
+	}
+	payload, _ := vBase58Bitcoin.decodeCheck(address)
+	return hex.EncodeToString(payload), nil
+}
+
+// TronFromHex accepts the hex form starting with `41` or an EVM address.
+func TronFromHex(hexAddress string) (string, error) {
+	hexAddress = strings.TrimPrefix(hexAddress, evmPrefix)
+	if len(hexAddress) == evmHexLength {
+		hexAddress = hex.EncodeToString([]byte{tronVersion}) + hexAddress
+	}
+	payload, err := hex.DecodeString(hexAddress)
+	if err != nil || len(payload) != evmHashLength+1 || payload[0] != tronVersion {
+		return "", ErrInvalidFormat
+	}
+	return vBase58Bitcoin.encodeCheck(payload), nil
+}
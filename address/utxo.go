@@ -0,0 +1,83 @@
+package address
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	utxoHashLength = 20
+)
+
+type tUtxoParams struct {
+	versions []byte
+	// segwitHrp is empty if the chain doesn't support segwit.
+	segwitHrp string
+	// cashAddrPrefix is only set for Bitcoin Cash.
+	cashAddrPrefix string
+	p2pkhVersion   byte
+	p2shVersion    byte
+}
+
+var (
+	vUtxoBitcoinParams = tUtxoParams{versions: []byte{0x00, 0x05}, segwitHrp: "bc"}
+	vUtxoBitcoinTest   = tUtxoParams{versions: []byte{0x6f, 0xc4}, segwitHrp: "tb"}
+
+	vUtxoBitcoinCashParams = tUtxoParams{versions: []byte{0x00, 0x05}, cashAddrPrefix: "bitcoincash", p2pkhVersion: 0x00, p2shVersion: 0x05}
+	vUtxoBitcoinCashTest   = tUtxoParams{versions: []byte{0x6f, 0xc4}, cashAddrPrefix: "bchtest", p2pkhVersion: 0x6f, p2shVersion: 0xc4}
+
+	vUtxoLitecoinParams = tUtxoParams{versions: []byte{0x30, 0x32, 0x05}, segwitHrp: "ltc"}
+	vUtxoLitecoinTest   = tUtxoParams{versions: []byte{0x6f, 0x3a, 0xc4}, segwitHrp: "tltc"}
+
+	vUtxoDogecoinParams = tUtxoParams{versions: []byte{0x1e, 0x16}}
+	vUtxoDogecoinTest   = tUtxoParams{versions: []byte{0x71, 0xc4}}
+)
+
+// normalizeUtxo keeps base58 addresses as is, lowercases segwit ones
+// and converts legacy Bitcoin Cash addresses to cashaddr.
+func normalizeUtxo(params tUtxoParams, input string) (string, error) {
+	if params.segwitHrp != "" && strings.HasPrefix(strings.ToLower(input), params.segwitHrp+"1") {
+		if err := decodeSegwit(params.segwitHrp, input); err != nil {
+			return "", err
+		}
+		return strings.ToLower(input), nil
+	}
+	if params.cashAddrPrefix != "" && !isBase58Legacy(input) {
+		addrType, hash, err := decodeCashAddr(params.cashAddrPrefix, input)
+		if err != nil {
+			return "", err
+		}
+		return encodeCashAddr(params.cashAddrPrefix, addrType, hash), nil
+	}
+
+	payload, err := vBase58Bitcoin.decodeCheck(input)
+	if err != nil {
+		return "", err
+	}
+	if len(payload) != utxoHashLength+1 {
+		return "", ErrInvalidLength
+	}
+	if !slices.Contains(params.versions, payload[0]) {
+		return "", ErrWrongNetwork
+	}
+	if params.cashAddrPrefix != "" {
+		addrType := cashAddrTypeP2PKH
+		if payload[0] == params.p2shVersion {
+			addrType = cashAddrTypeP2SH
+		}
+		return encodeCashAddr(params.cashAddrPrefix, addrType, payload[1:]), nil
+	}
+	return input, nil
+}
+
+// isBase58Legacy distinguishes legacy Bitcoin Cash addresses, cashaddr ones start with `q`/`p` or the prefix.
+func isBase58Legacy(input string) bool {
+	if input == "" || strings.Contains(input, ":") {
+		return false
+	}
+	switch input[0] {
+	case 'q', 'p', 'Q', 'P':
+		return false
+	}
+	return true
+}
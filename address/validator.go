@@ -0,0 +1,37 @@
+package address
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/kiyuu10/common-lib-go/gconsts"
+	"github.com/kiyuu10/common-lib-go/gmeta"
+	"github.com/kiyuu10/common-lib-go/types"
+)
+
+var vInstallValidatorOnce sync.Once
+
+// InstallValidator registers the `coin_address` tag checking addresses by Validate on DefaultValidator,
+// including an already created one, and on validators created later.
+// It must be called on initialization before structs having the tag are validated, calling it again is a no-op.
+func InstallValidator() {
+	vInstallValidatorOnce.Do(func() {
+		types.ValidatorRegisterValidation(gconsts.ValidatorTagCoinAddress, validateCoinAddress)
+		types.ValidatorRegisterDefaultMessage(gconsts.ValidatorTagCoinAddress, "{{.field}} must be a valid address")
+	})
+}
+
+// validateCoinAddress is used as `coin_address=Network`, the param is the struct field name of the network.
+func validateCoinAddress(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+	networkField, networkKind, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param())
+	if !ok || networkKind != reflect.String {
+		return false
+	}
+	return Validate(gmeta.BlockchainNetwork(networkField.String()), field.String()) == nil
+}
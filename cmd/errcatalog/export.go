@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/kiyuu10/common-lib-go/gmeta"
+	"github.com/kiyuu10/common-lib-go/locale"
+)
+
+type tCatalogItem struct {
+	gmeta.ErrorCodeMeta
+	GrpcCodeName string            `json:"grpc_code_name"`
+	Messages     map[string]string `json:"messages,omitempty"`
+}
+
+func buildCatalog(metas []gmeta.ErrorCodeMeta, bundle *locale.Bundle) []tCatalogItem {
+	items := make([]tCatalogItem, len(metas))
+	for i, meta := range metas {
+		items[i] = tCatalogItem{
+			ErrorCodeMeta: meta,
+			GrpcCodeName:  meta.GrpcCode.String(),
+		}
+		if bundle == nil {
+			continue
+		}
+		items[i].Messages = make(map[string]string)
+		for _, lang := range bundle.Languages() {
+			if !bundle.HasKey(lang, meta.Code.String()) {
+				continue
+			}
+			// Templates are rendered without data, placeholders become empty.
+			message, err := bundle.TranslateKeyData(locale.WithLanguage(context.Background(), lang), meta.Code.String(), nil)
+			if err == nil {
+				items[i].Messages[lang] = message
+			}
+		}
+	}
+	return items
+}
+
+func exportJSON(w io.Writer, metas []gmeta.ErrorCodeMeta, bundle *locale.Bundle) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(buildCatalog(metas, bundle))
+}
+
+func exportMarkdown(w io.Writer, metas []gmeta.ErrorCodeMeta, bundle *locale.Bundle) error {
+	var langs []string
+	if bundle != nil {
+		langs = bundle.Languages()
+		sort.Strings(langs)
+	}
+	var sb strings.Builder
+	sb.WriteString("| Code | HTTP | gRPC | Retryable | Description |")
+	for _, lang := range langs {
+		sb.WriteString(" " + lang + " |")
+	}
+	sb.WriteString("\n|---|---|---|---|---|")
+	sb.WriteString(strings.Repeat("---|", len(langs)))
+	sb.WriteString("\n")
+	for _, item := range buildCatalog(metas, bundle) {
+		fmt.Fprintf(&sb, "| `%s` | %d | %s | %t | %s |",
+			item.Code, item.HttpStatus, item.GrpcCodeName, item.Retryable, markdownEscape(item.Description))
+		for _, lang := range langs {
+			sb.WriteString(" " + markdownEscape(item.Messages[lang]) + " |")
+		}
+		sb.WriteString("\n")
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func markdownEscape(text string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(text, "|", `\|`), "\n", " ")
+}
@@ -0,0 +1,99 @@
+// Command errcatalog verifies the error catalog and exports it for other teams.
+//
+//	errcatalog -locales ./locales -source ./gconsts/error.go -format markdown -out errors.md
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	_ "github.com/kiyuu10/common-lib-go/gconsts"
+	"github.com/kiyuu10/common-lib-go/gmeta"
+	"github.com/kiyuu10/common-lib-go/locale"
+)
+
+const (
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+// run returns the exit code so deferred calls are done before the process exits.
+func run() (code int) {
+	var (
+		localesDir = flag.String("locales", "", "directory of locale bundles to verify translations")
+		sourcePath = flag.String("source", "", "Go source declaring ErrorCode constants and OurError vars")
+		format     = flag.String("format", FormatJSON, "export format: json or markdown")
+		outPath    = flag.String("out", "", "export file, stdout if empty")
+		verifyOnly = flag.Bool("verify", false, "verify only, don't export")
+	)
+	flag.Parse()
+
+	var (
+		metas    = gmeta.ListErrorCodeMetas()
+		problems []string
+		bundle   *locale.Bundle
+	)
+	problems = append(problems, verifyMetas(metas)...)
+	if *localesDir != "" {
+		bundle = locale.NewBundle(locale.DefaultLanguage)
+		if err := bundle.LoadDir(*localesDir); err != nil {
+			return printError(err)
+		}
+		problems = append(problems, verifyTranslations(metas, bundle)...)
+	}
+	if *sourcePath != "" {
+		sourceProblems, err := verifySource(*sourcePath, metas)
+		if err != nil {
+			return printError(err)
+		}
+		problems = append(problems, sourceProblems...)
+	}
+	for _, problem := range problems {
+		fmt.Fprintln(os.Stderr, problem)
+	}
+	if len(problems) > 0 {
+		fmt.Fprintf(os.Stderr, "%d problem(s) found\n", len(problems))
+		return 1
+	}
+	if *verifyOnly {
+		return 0
+	}
+
+	var output io.Writer = os.Stdout
+	if *outPath != "" {
+		file, err := os.Create(*outPath)
+		if err != nil {
+			return printError(err)
+		}
+		defer func() {
+			if err := file.Close(); err != nil && code == 0 {
+				code = printError(err)
+			}
+		}()
+		output = file
+	}
+	var err error
+	switch *format {
+	case FormatJSON:
+		err = exportJSON(output, metas, bundle)
+	case FormatMarkdown:
+		err = exportMarkdown(output, metas, bundle)
+	default:
+		err = fmt.Errorf("unsupported format: %s", *format)
+	}
+	if err != nil {
+		return printError(err)
+	}
+	return 0
+}
+
+func printError(err error) int {
+	fmt.Fprintln(os.Stderr, err.Error())
+	return 1
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kiyuu10/common-lib-go/gmeta"
+	"github.com/kiyuu10/common-lib-go/locale"
+)
+
+const (
+	constPrefix = "ErrorCode"
+	varPrefix   = "Error"
+)
+
+func verifyMetas(metas []gmeta.ErrorCodeMeta) (problems []string) {
+	for _, meta := range metas {
+		if meta.Description == "" {
+			problems = append(problems, fmt.Sprintf("%s: missing description", meta.Code))
+		}
+		if http.StatusText(meta.HttpStatus) == "" {
+			problems = append(problems, fmt.Sprintf("%s: invalid http status %d", meta.Code, meta.HttpStatus))
+		}
+	}
+	return
+}
+
+func verifyTranslations(metas []gmeta.ErrorCodeMeta, bundle *locale.Bundle) (problems []string) {
+	langs := bundle.Languages()
+	sort.Strings(langs)
+	if len(langs) == 0 {
+		return []string{"locales: no bundle found"}
+	}
+	for _, lang := range langs {
+		for _, meta := range metas {
+			if !bundle.HasKey(lang, meta.Code.String()) {
+				problems = append(problems, fmt.Sprintf("%s: missing translation in %q", meta.Code, lang))
+			}
+		}
+	}
+	return
+}
+
+// verifySource checks `ErrorX = gmeta.NewOurError(ErrorCodeX)` declarations are named consistently,
+// every code has a var and every code is registered in the catalog.
+func verifySource(path string, metas []gmeta.ErrorCodeMeta) (problems []string, err error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parse source: %w", err)
+	}
+	var (
+		codeValues = make(map[string]string)   // const name -> code value
+		codeVars   = make(map[string][]string) // const name -> var names
+		registered = make(map[string]bool, len(metas))
+	)
+	for _, meta := range metas {
+		registered[meta.Code.String()] = true
+	}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec, ok := spec.(*ast.ValueSpec)
+			if !ok || len(valueSpec.Names) != 1 || len(valueSpec.Values) != 1 {
+				continue
+			}
+			name := valueSpec.Names[0].Name
+			switch genDecl.Tok {
+			case token.CONST:
+				if lit, ok := valueSpec.Values[0].(*ast.BasicLit); ok && strings.HasPrefix(name, constPrefix) {
+					codeValues[name], _ = strconv.Unquote(lit.Value)
+				}
+			case token.VAR:
+				if constName, ok := newOurErrorArg(valueSpec.Values[0]); ok {
+					codeVars[constName] = append(codeVars[constName], name)
+				}
+			}
+		}
+	}
+
+	constNames := make([]string, 0, len(codeValues))
+	for constName := range codeValues {
+		constNames = append(constNames, constName)
+	}
+	sort.Strings(constNames)
+	for _, constName := range constNames {
+		code := codeValues[constName]
+		if !registered[code] {
+			problems = append(problems, fmt.Sprintf("%s: %s is not registered in the catalog", code, constName))
+		}
+		varNames, ok := codeVars[constName]
+		if !ok {
+			if code != "success" {
+				problems = append(problems, fmt.Sprintf("%s: %s has no OurError var", code, constName))
+			}
+			continue
+		}
+		// Legacy aliases are allowed as long as the consistent name exists.
+		expected := varPrefix + strings.TrimPrefix(constName, constPrefix)
+		if !slices.Contains(varNames, expected) {
+			problems = append(problems, fmt.Sprintf("%s: var %s should be named %s", code, varNames[0], expected))
+		}
+	}
+	for constName, varNames := range codeVars {
+		if _, ok := codeValues[constName]; !ok {
+			problems = append(problems, fmt.Sprintf("var %s uses undeclared %s", varNames[0], constName))
+		}
+	}
+	return problems, nil
+}
+
+func newOurErrorArg(expr ast.Expr) (string, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return "", false
+	}
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != "NewOurError" {
+		return "", false
+	}
+	arg, ok := call.Args[0].(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	return arg.Name, true
+}
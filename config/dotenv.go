@@ -0,0 +1,60 @@
+package config
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+// ParseDotEnv reads `KEY=VALUE` lines, supports comments, `export` prefix and quoted values.
+func ParseDotEnv(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, erroy.WrapStack(err, "config: open dotenv file").WithField("path", path)
+	}
+	defer file.Close()
+
+	var (
+		values  = make(map[string]string)
+		scanner = bufio.NewScanner(file)
+		lineNo  = 0
+	)
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, erroy.NewWithStack("config: invalid dotenv line").
+				WithField("path", path).
+				WithField("line", lineNo)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		switch {
+		case strings.HasPrefix(value, `"`):
+			if value, err = strconv.Unquote(value); err != nil {
+				return nil, erroy.WrapStack(err, "config: unquote dotenv value").
+					WithField("path", path).
+					WithField("line", lineNo)
+			}
+		case strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'") && len(value) > 1:
+			value = value[1 : len(value)-1]
+		default:
+			if idx := strings.Index(value, " #"); idx >= 0 {
+				value = strings.TrimSpace(value[:idx])
+			}
+		}
+		values[key] = value
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, erroy.WrapStack(err, "config: read dotenv file").WithField("path", path)
+	}
+	return values, nil
+}
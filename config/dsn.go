@@ -0,0 +1,32 @@
+package config
+
+import (
+	"net/url"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+// DSN is a URL-style value like `redis://host:6379?db=1&pool_size=10`.
+type DSN struct {
+	*url.URL
+}
+
+func (d *DSN) UnmarshalText(text []byte) error {
+	dsnURL, err := url.Parse(string(text))
+	if err != nil {
+		return erroy.WrapStack(err, "config: parse dsn")
+	}
+	d.URL = dsnURL
+	return nil
+}
+
+func (d DSN) MarshalText() ([]byte, error) {
+	if d.URL == nil {
+		return nil, nil
+	}
+	return []byte(d.URL.String()), nil
+}
+
+func (d DSN) IsEmpty() bool {
+	return d.URL == nil || d.URL.String() == ""
+}
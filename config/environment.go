@@ -0,0 +1,70 @@
+package config
+
+import (
+	"os"
+	"strings"
+
+	atomicobj "go.uber.org/atomic"
+)
+
+const (
+	EnvironmentVarName = "APP_ENV"
+)
+
+type Environment string
+
+const (
+	EnvironmentTest Environment = "test"
+	EnvironmentDev  Environment = "dev"
+	EnvironmentProd Environment = "prod"
+)
+
+// ParseEnvironment accepts the common aliases, unknown values fall back to production.
+func ParseEnvironment(value string) Environment {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "test", "testing":
+		return EnvironmentTest
+	case "dev", "develop", "development", "local":
+		return EnvironmentDev
+	default:
+		return EnvironmentProd
+	}
+}
+
+func (e Environment) String() string {
+	return string(e)
+}
+
+func (e Environment) IsTest() bool {
+	return e == EnvironmentTest
+}
+
+func (e Environment) IsDev() bool {
+	return e == EnvironmentDev
+}
+
+func (e Environment) IsProd() bool {
+	return e == EnvironmentProd
+}
+
+var vEnvironment = atomicobj.NewString(string(ParseEnvironment(os.Getenv(EnvironmentVarName))))
+
+func GetEnvironment() Environment {
+	return Environment(vEnvironment.Load())
+}
+
+func SetEnvironment(env Environment) {
+	vEnvironment.Store(string(env))
+}
+
+func IsTest() bool {
+	return GetEnvironment().IsTest()
+}
+
+func IsDev() bool {
+	return GetEnvironment().IsDev()
+}
+
+func IsProd() bool {
+	return GetEnvironment().IsProd()
+}
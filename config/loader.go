@@ -0,0 +1,306 @@
+package config
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+	"github.com/kiyuu10/common-lib-go/types"
+)
+
+const (
+	TagEnv     = "env"
+	TagDefault = "default"
+
+	envSliceSep = ","
+)
+
+var (
+	vTextUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	vDurationType        = reflect.TypeOf(time.Duration(0))
+)
+
+type LoadOptions struct {
+	// Files are JSON or YAML files decoded by `json` tags, the later files override the former ones.
+	Files []string
+	// EnvFiles are .env files, the real environment variables have higher priority.
+	EnvFiles []string
+	// EnvPrefix is prepended to every `env` tag.
+	EnvPrefix string
+
+	SkipValidation bool
+}
+
+// Load populates `target` (a pointer to struct) in order: `default` tags, files, .env files
+// then environment variables named by `env` tags. Fields implement encoding.TextUnmarshaler
+// such as types.PlaceholderSecret, types.TimeDuration or gmeta.AmountMarkup are decoded from text.
+// A nil pointer to nested struct stays nil unless one of its fields gets a value from the environment,
+// nested structs of other packages (time.Time...) are skipped unless they declare the tags.
+func Load(target any, opts LoadOptions) error {
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Pointer || targetValue.IsNil() || targetValue.Elem().Kind() != reflect.Struct {
+		return erroy.NewWithStack("config: target must be a non-nil pointer to struct").
+			WithField("type", fmt.Sprintf("%T", target))
+	}
+
+	pkgPath := targetValue.Elem().Type().PkgPath()
+	defaults := tTagApplier{
+		tagName: TagDefault,
+		lookup:  lookupDefault,
+		pkgPath: pkgPath,
+	}
+	if _, err := defaults.apply(targetValue.Elem()); err != nil {
+		return err
+	}
+	for _, path := range opts.Files {
+		if err := LoadFile(path, target); err != nil {
+			return err
+		}
+	}
+
+	envValues := make(map[string]string)
+	for _, path := range opts.EnvFiles {
+		values, err := ParseDotEnv(path)
+		if err != nil {
+			return err
+		}
+		for key, value := range values {
+			envValues[key] = value
+		}
+	}
+	lookupEnv := func(key string) (string, bool) {
+		if value, ok := os.LookupEnv(key); ok {
+			return value, true
+		}
+		value, ok := envValues[key]
+		return value, ok
+	}
+	envs := tTagApplier{
+		tagName: TagEnv,
+		prefix:  opts.EnvPrefix,
+		lookup:  lookupEnv,
+		pkgPath: pkgPath,
+	}
+	if _, err := envs.apply(targetValue.Elem()); err != nil {
+		return err
+	}
+
+	if opts.SkipValidation {
+		return nil
+	}
+	if err := types.ValidateStruct(target); err != nil {
+		return erroy.WrapMessage(err, "config: validate")
+	}
+	return nil
+}
+
+// LoadFile decodes JSON or YAML file by extension. YAML is converted to JSON first
+// so both formats share the `json` tags and text unmarshalers.
+func LoadFile(path string, target any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return erroy.WrapStack(err, "config: read file").WithField("path", path)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+	case ".yaml", ".yml":
+		var values map[string]any
+		if err = yaml.Unmarshal(data, &values); err != nil {
+			return erroy.WrapStack(err, "config: decode yaml").WithField("path", path)
+		}
+		if data, err = json.Marshal(values); err != nil {
+			return erroy.WrapStack(err, "config: encode yaml as json").WithField("path", path)
+		}
+	default:
+		return erroy.NewWithStack("config: unsupported file").WithField("path", path)
+	}
+	if err = json.Unmarshal(data, target); err != nil {
+		return erroy.WrapStack(err, "config: decode json").WithField("path", path)
+	}
+	return nil
+}
+
+func lookupDefault(key string) (string, bool) {
+	return key, key != ""
+}
+
+type tTagApplier struct {
+	tagName string
+	prefix  string
+	lookup  func(key string) (string, bool)
+	// pkgPath is the package of the target, nested structs of other packages are applied only if they declare the tag.
+	pkgPath string
+}
+
+// apply reports whether any field is set, so a nil pointer to nested struct is allocated only when it gets a value,
+// the defaults of the allocated struct are applied first.
+func (a tTagApplier) apply(structValue reflect.Value) (applied bool, err error) {
+	structType := structValue.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		var (
+			field      = structType.Field(i)
+			fieldValue = structValue.Field(i)
+		)
+		if !field.IsExported() {
+			continue
+		}
+		tag, hasTag := field.Tag.Lookup(a.tagName)
+		if !hasTag {
+			if !a.isNestedStruct(field.Type) {
+				continue
+			}
+			nestedApplied, err := a.applyNested(fieldValue)
+			if err != nil {
+				return applied, err
+			}
+			applied = applied || nestedApplied
+			continue
+		}
+		if a.tagName == TagEnv {
+			tag = a.prefix + tag
+		}
+		text, ok := a.lookup(tag)
+		if !ok {
+			continue
+		}
+		if err := setFieldText(fieldValue, text); err != nil {
+			return applied, erroy.WrapMessage(err, "config: set field").
+				WithField("field", field.Name).
+				WithField("tag", a.tagName)
+		}
+		applied = true
+	}
+	return applied, nil
+}
+
+func (a tTagApplier) applyNested(fieldValue reflect.Value) (bool, error) {
+	if fieldValue.Kind() != reflect.Pointer {
+		return a.apply(fieldValue)
+	}
+	if !fieldValue.IsNil() {
+		return a.apply(fieldValue.Elem())
+	}
+	if a.tagName == TagDefault {
+		return false, nil
+	}
+	nested := reflect.New(fieldValue.Type().Elem())
+	defaults := tTagApplier{tagName: TagDefault, lookup: lookupDefault, pkgPath: a.pkgPath}
+	if _, err := defaults.apply(nested.Elem()); err != nil {
+		return false, err
+	}
+	applied, err := a.apply(nested.Elem())
+	if applied && err == nil {
+		fieldValue.Set(nested)
+	}
+	return applied, err
+}
+
+func (a tTagApplier) isNestedStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t.Implements(vTextUnmarshalerType) || reflect.PointerTo(t).Implements(vTextUnmarshalerType) {
+		return false
+	}
+	return t.Name() == "" || t.PkgPath() == a.pkgPath || hasFieldTag(t, a.tagName, make(map[reflect.Type]bool))
+}
+
+// hasFieldTag reports whether the struct or its nested structs declare the tag.
+func hasFieldTag(t reflect.Type, tagName string, visited map[reflect.Type]bool) bool {
+	if visited[t] {
+		return false
+	}
+	visited[t] = true
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if _, ok := field.Tag.Lookup(tagName); ok {
+			return true
+		}
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct && hasFieldTag(fieldType, tagName, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+func setFieldText(value reflect.Value, text string) error {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			value.Set(reflect.New(value.Type().Elem()))
+		}
+		return setFieldText(value.Elem(), text)
+	}
+	if value.CanAddr() {
+		if unmarshaler, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return unmarshaler.UnmarshalText([]byte(text))
+		}
+	}
+	if value.Type() == vDurationType {
+		duration, err := time.ParseDuration(text)
+		if err != nil {
+			return erroy.WrapStack(err, "parse duration")
+		}
+		value.SetInt(int64(duration))
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(text)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(text)
+		if err != nil {
+			return erroy.WrapStack(err, "parse bool")
+		}
+		value.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(text, 10, value.Type().Bits())
+		if err != nil {
+			return erroy.WrapStack(err, "parse int")
+		}
+		value.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(text, 10, value.Type().Bits())
+		if err != nil {
+			return erroy.WrapStack(err, "parse uint")
+		}
+		value.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(text, value.Type().Bits())
+		if err != nil {
+			return erroy.WrapStack(err, "parse float")
+		}
+		value.SetFloat(parsed)
+	case reflect.Slice:
+		var parts []string
+		if text != "" {
+			parts = strings.Split(text, envSliceSep)
+		}
+		slice := reflect.MakeSlice(value.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := setFieldText(slice.Index(i), strings.TrimSpace(part)); err != nil {
+				return err
+			}
+		}
+		value.Set(slice)
+	default:
+		return erroy.NewWithStack("unsupported field type").WithField("type", value.Type().String())
+	}
+	return nil
+}
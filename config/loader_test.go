@@ -0,0 +1,146 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type tTestDatabase struct {
+	Host string `json:"host" default:"localhost" env:"DB_HOST"`
+	Port int    `json:"port" default:"5432" env:"DB_PORT"`
+}
+
+type tTestCache struct {
+	Addr string `json:"addr" env:"CACHE_ADDR"`
+}
+
+type tTestConfig struct {
+	Name     string        `json:"name" default:"app" env:"NAME"`
+	Debug    bool          `json:"debug" env:"DEBUG"`
+	Timeout  time.Duration `json:"timeout" default:"5s" env:"TIMEOUT"`
+	Tags     []string      `json:"tags" env:"TAGS"`
+	Database tTestDatabase `json:"database"`
+	Cache    *tTestCache   `json:"cache"`
+	Started  time.Time     `json:"started"`
+	Optional *tTestDatabase
+}
+
+func TestLoadDefaults(t *testing.T) {
+	var cfg tTestConfig
+	if err := Load(&cfg, LoadOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Name != "app" || cfg.Timeout != 5*time.Second || cfg.Database.Host != "localhost" || cfg.Database.Port != 5432 {
+		t.Fatalf("defaults aren't applied: %+v", cfg)
+	}
+	if cfg.Cache != nil || cfg.Optional != nil {
+		t.Fatalf("nested pointers without values are allocated: %+v, %+v", cfg.Cache, cfg.Optional)
+	}
+	if !cfg.Started.IsZero() {
+		t.Fatalf("foreign struct is changed: %v", cfg.Started)
+	}
+}
+
+func TestLoadEnv(t *testing.T) {
+	t.Setenv("TEST_NAME", "svc")
+	t.Setenv("TEST_DEBUG", "true")
+	t.Setenv("TEST_TAGS", "a, b")
+	t.Setenv("TEST_DB_PORT", "6432")
+	t.Setenv("TEST_CACHE_ADDR", "redis:6379")
+
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("name: file\ntimeout: 1000000000\ndatabase:\n  host: db\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	envPath := filepath.Join(dir, ".env")
+	if err := os.WriteFile(envPath, []byte("TEST_NAME=dotenv\nTEST_TIMEOUT=3s\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var cfg tTestConfig
+	if err := Load(&cfg, LoadOptions{Files: []string{configPath}, EnvFiles: []string{envPath}, EnvPrefix: "TEST_"}); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Name != "svc" || !cfg.Debug || cfg.Timeout != 3*time.Second {
+		t.Fatalf("env doesn't override: %+v", cfg)
+	}
+	if len(cfg.Tags) != 2 || cfg.Tags[0] != "a" || cfg.Tags[1] != "b" {
+		t.Fatalf("Tags = %q", cfg.Tags)
+	}
+	if cfg.Database.Host != "db" || cfg.Database.Port != 6432 {
+		t.Fatalf("Database = %+v", cfg.Database)
+	}
+	if cfg.Cache == nil || cfg.Cache.Addr != "redis:6379" {
+		t.Fatalf("Cache = %+v", cfg.Cache)
+	}
+	if cfg.Optional == nil || cfg.Optional.Host != "localhost" || cfg.Optional.Port != 6432 {
+		t.Fatalf("Optional = %+v", cfg.Optional)
+	}
+}
+
+func TestLoadNestedPointerStaysNil(t *testing.T) {
+	var cfg struct {
+		Cache *tTestCache
+		Clock *time.Time
+	}
+	if err := Load(&cfg, LoadOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Cache != nil || cfg.Clock != nil {
+		t.Fatalf("nil pointers are allocated: %+v", cfg)
+	}
+
+	existing := &tTestCache{Addr: "keep"}
+	cfg.Cache = existing
+	if err := Load(&cfg, LoadOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Cache != existing || cfg.Cache.Addr != "keep" {
+		t.Fatalf("existing pointer is replaced: %+v", cfg.Cache)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		env  string
+		path string
+	}{
+		{"int", "TEST_DB_PORT", ""},
+		{"bool", "TEST_DEBUG", ""},
+		{"duration", "TEST_TIMEOUT", ""},
+		{"missing file", "", "missing.json"},
+		{"unsupported file", "", "config.toml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts LoadOptions
+			if tt.env != "" {
+				t.Setenv(tt.env, "invalid")
+				opts.EnvPrefix = "TEST_"
+			}
+			if tt.path != "" {
+				path := filepath.Join(t.TempDir(), tt.path)
+				if filepath.Ext(path) != ".json" {
+					if err := os.WriteFile(path, nil, 0o600); err != nil {
+						t.Fatal(err)
+					}
+				}
+				opts.Files = []string{path}
+			}
+			var cfg tTestConfig
+			if err := Load(&cfg, opts); err == nil {
+				t.Fatalf("Load(%+v) must fail", opts)
+			}
+		})
+	}
+
+	for _, target := range []any{nil, tTestConfig{}, (*tTestConfig)(nil), new(int)} {
+		if err := Load(target, LoadOptions{}); err == nil {
+			t.Fatalf("Load(%T) must fail", target)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package erroy
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"syscall"
+)
+
+type Severity int8
+
+const (
+	SeverityUnset Severity = iota
+	SeverityDebug
+	SeverityInfo
+	SeverityWarning
+	SeverityError
+	SeverityFatal
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityDebug:
+		return "debug"
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	case SeverityFatal:
+		return "fatal"
+	default:
+		return "unset"
+	}
+}
+
+type (
+	// Retryabler is implemented by errors deciding by themselves, such as gmeta.OurError by its code.
+	Retryabler interface {
+		Retryable() bool
+	}
+	Temporarier interface {
+		Temporary() bool
+	}
+	UserFacer interface {
+		UserFacing() bool
+	}
+	Severitier interface {
+		Severity() Severity
+	}
+)
+
+// tFlag is a tri-state so unset attributes are inherited from wrapped errors.
+type tFlag int8
+
+const (
+	cFlagUnset tFlag = iota
+	cFlagTrue
+	cFlagFalse
+)
+
+func newFlag(value bool) tFlag {
+	if value {
+		return cFlagTrue
+	}
+	return cFlagFalse
+}
+
+type tClassification struct {
+	retryable  tFlag
+	temporary  tFlag
+	userFacing tFlag
+	severity   Severity
+}
+
+var (
+	vRetryableErrorsMux sync.RWMutex
+	vRetryableErrors    []error
+	// vRetryableErrnos are connections dropped by the peer after they're established.
+	vRetryableErrnos = []error{syscall.ECONNRESET, syscall.ECONNABORTED, syscall.EPIPE}
+)
+
+// RegisterRetryableError marks sentinel errors as retryable, they're matched by errors.Is.
+func RegisterRetryableError(errs ...error) {
+	vRetryableErrorsMux.Lock()
+	defer vRetryableErrorsMux.Unlock()
+	vRetryableErrors = append(vRetryableErrors, errs...)
+}
+
+func isRegisteredRetryable(err error) bool {
+	vRetryableErrorsMux.RLock()
+	defer vRetryableErrorsMux.RUnlock()
+	for _, retryableErr := range vRetryableErrors {
+		if errors.Is(err, retryableErr) {
+			return true
+		}
+	}
+	return false
+}
+
+// classify returns the attribute of the outermost layer having it.
+func classify(err error, getFlag func(layer error) tFlag) (bool, bool) {
+	for _, layer := range Layers(err) {
+		switch getFlag(layer) {
+		case cFlagTrue:
+			return true, true
+		case cFlagFalse:
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// IsRetryable understands attributes of erroy errors, Retryabler such as gmeta.OurError,
+// registered sentinels, context errors, net timeouts and dropped connections.
+// A refused connection or a DNS failure is likely a configuration issue so it isn't retryable,
+// register syscall.ECONNREFUSED if peers are expected to restart.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if retryable, ok := classify(err, func(layer error) tFlag {
+		switch layerT := layer.(type) {
+		case tError:
+			return layerT.class.retryable
+		case Retryabler:
+			return newFlag(layerT.Retryable())
+		}
+		return cFlagUnset
+	}); ok {
+		return retryable
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return false
+	case errors.Is(err, context.DeadlineExceeded), isRegisteredRetryable(err):
+		return true
+	}
+	for _, errno := range vRetryableErrnos {
+		if errors.Is(err, errno) {
+			return true
+		}
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func IsTemporary(err error) bool {
+	if err == nil {
+		return false
+	}
+	if temporary, ok := classify(err, func(layer error) tFlag {
+		switch layerT := layer.(type) {
+		case tError:
+			return layerT.class.temporary
+		case Temporarier:
+			return newFlag(layerT.Temporary())
+		}
+		return cFlagUnset
+	}); ok {
+		return temporary
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// IsUserFacing reports whether the message can be shown to end users, errors are internal by default.
+func IsUserFacing(err error) bool {
+	userFacing, _ := classify(err, func(layer error) tFlag {
+		switch layerT := layer.(type) {
+		case tError:
+			return layerT.class.userFacing
+		case UserFacer:
+			return newFlag(layerT.UserFacing())
+		}
+		return cFlagUnset
+	})
+	return userFacing
+}
+
+// GetSeverity returns SeverityError when no layer has a severity.
+func GetSeverity(err error) Severity {
+	for _, layer := range Layers(err) {
+		var severity Severity
+		switch layerT := layer.(type) {
+		case tError:
+			severity = layerT.class.severity
+		case Severitier:
+			severity = layerT.Severity()
+		}
+		if severity != SeverityUnset {
+			return severity
+		}
+	}
+	return SeverityError
+}
@@ -0,0 +1,49 @@
+package erroy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+)
+
+type tTimeoutError struct{}
+
+func (tTimeoutError) Error() string   { return "i/o timeout" }
+func (tTimeoutError) Timeout() bool   { return true }
+func (tTimeoutError) Temporary() bool { return true }
+
+func TestIsRetryable(t *testing.T) {
+	newOpErr := func(err error) error {
+		return &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", err)}
+	}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"plain", errors.New("plain"), false},
+		{"eof", io.EOF, false},
+		{"canceled", context.Canceled, false},
+		{"deadline", fmt.Errorf("query: %w", context.DeadlineExceeded), true},
+		{"connection refused", newOpErr(syscall.ECONNREFUSED), false},
+		{"dns failure", &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "x"}}, false},
+		{"connection reset", newOpErr(syscall.ECONNRESET), true},
+		{"broken pipe", Wrap(newOpErr(syscall.EPIPE)), true},
+		{"timeout", &net.OpError{Op: "read", Net: "tcp", Err: tTimeoutError{}}, true},
+		{"explicit", Wrap(newOpErr(syscall.ECONNREFUSED)).WithRetryable(true), true},
+		{"explicit false", Wrap(newOpErr(syscall.ECONNRESET)).WithRetryable(false), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
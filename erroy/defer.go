@@ -1,10 +1,5 @@
 package erroy
 
-import (
-	"fmt"
-	"log"
-)
-
 var vRootDeferFunctions []func()
 
 func RegisterRootDefer(deferFunc func()) {
@@ -21,13 +16,5 @@ func ExecuteRootDefers() {
 }
 
 func recoverRootDefer() {
-	errObj := recover()
-	if errObj == nil {
-		return
-	}
-	err, ok := errObj.(error)
-	if !ok {
-		err = fmt.Errorf("error: %v", errObj)
-	}
-	log.Printf("execute root defer failed | err=%s\n", err.Error())
+	recoverPanic(recover(), "execute root defer failed")
 }
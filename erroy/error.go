@@ -2,16 +2,14 @@ package erroy
 
 import (
 	"errors"
-	"fmt"
-	"strings"
-
-	"github.com/getsentry/sentry-go"
+	"maps"
 )
 
 type tError struct {
-	err        error
-	stacktrace *sentry.Stacktrace
-	data       map[string]any
+	err   error
+	stack Stack
+	data  map[string]any
+	class tClassification
 }
 
 func (e tError) Error() string {
@@ -28,46 +26,74 @@ func (e tError) RawError() string {
 func (e tError) FullError() string {
 	errMsg := e.err.Error()
 	if len(e.data) > 0 {
-		var (
-			formats = make([]string, 0, len(e.data))
-			args    = make([]any, 0, len(e.data)*2)
-		)
-		for key, value := range e.data {
-			formats = append(formats, "%s=%+v")
-			args = append(args, key, value)
-		}
-		valuesStr := fmt.Sprintf(strings.Join(formats, ","), args...)
-		errMsg += " | " + valuesStr
+		errMsg += " | " + FormatData(e.data)
 	}
 	return errMsg
 }
 
 func (e tError) Unwrap() error {
-	return errors.Unwrap(e.err)
+	return e.err
+}
+
+// Is continues errors.Is on legacy chains which only implement Causer.
+func (e tError) Is(target error) bool {
+	return matchCauseChain(e.err, func(err error) bool {
+		return errors.Is(err, target)
+	})
+}
+
+// As continues errors.As on legacy chains which only implement Causer.
+func (e tError) As(target any) bool {
+	return matchCauseChain(e.err, func(err error) bool {
+		return errors.As(err, target)
+	})
 }
 
-func (e tError) Stacktrace() *sentry.Stacktrace {
-	return e.stacktrace
+func (e tError) Stacktrace() Stack {
+	return e.stack
 }
 
+// Data returns a copy, the data of an error is never modified after creation.
 func (e tError) Data() map[string]any {
-	return e.data
+	return maps.Clone(e.data)
 }
 
+// WithField is copy-on-write, so it's safe to decorate sentinel errors concurrently.
 func (e tError) WithField(key string, value any) Error {
-	if e.data == nil {
-		e.data = make(map[string]any, 1)
-	}
-	e.data[key] = value
+	data := make(map[string]any, len(e.data)+1)
+	maps.Copy(data, e.data)
+	data[key] = value
+	e.data = data
 	return e
 }
 
 func (e tError) WithFields(data map[string]any) Error {
-	if e.data == nil {
-		e.data = make(map[string]any, len(data))
-	}
-	for key, value := range data {
-		e.data[key] = value
+	if len(data) == 0 {
+		return e
 	}
+	newData := make(map[string]any, len(e.data)+len(data))
+	maps.Copy(newData, e.data)
+	maps.Copy(newData, data)
+	e.data = newData
+	return e
+}
+
+func (e tError) WithRetryable(retryable bool) Error {
+	e.class.retryable = newFlag(retryable)
+	return e
+}
+
+func (e tError) WithTemporary(temporary bool) Error {
+	e.class.temporary = newFlag(temporary)
+	return e
+}
+
+func (e tError) WithUserFacing(userFacing bool) Error {
+	e.class.userFacing = newFlag(userFacing)
+	return e
+}
+
+func (e tError) WithSeverity(severity Severity) Error {
+	e.class.severity = severity
 	return e
 }
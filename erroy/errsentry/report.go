@@ -0,0 +1,192 @@
+package errsentry
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+const (
+	ReportTagErrorCode      = "error_code"
+	reportFingerprintNoCode = "{{ default }}"
+)
+
+type ReportOptions struct {
+	// SampleRates by error code, codes without a rate use DefaultSampleRate.
+	SampleRates       map[string]float64
+	DefaultSampleRate float64
+	// DedupeInterval drops events of the same fingerprint reported within the interval.
+	DedupeInterval time.Duration
+}
+
+type Reporter struct {
+	opts ReportOptions
+
+	dedupeMux   sync.Mutex
+	dedupeTimes map[string]time.Time
+}
+
+var vDefaultReporter = NewReporter(ReportOptions{DefaultSampleRate: 1})
+
+// Install makes erroy.Report send errors by the default reporter, it must be called on initialization.
+// Importing the package alone doesn't change erroy.Report.
+func Install() {
+	erroy.SetReportFunc(func(ctx context.Context, err error) {
+		Report(ctx, err)
+	})
+}
+
+func NewReporter(opts ReportOptions) *Reporter {
+	return &Reporter{
+		opts:        opts,
+		dedupeTimes: make(map[string]time.Time),
+	}
+}
+
+// SetDefaultReporter must be called on initialization, it isn't safe for concurrent use.
+func SetDefaultReporter(reporter *Reporter) {
+	vDefaultReporter = reporter
+}
+
+// Report sends the error to Sentry by the hub in context or the current hub.
+func Report(ctx context.Context, err error) *sentry.EventID {
+	return vDefaultReporter.Report(ctx, err)
+}
+
+func (r *Reporter) Report(ctx context.Context, err error) *sentry.EventID {
+	if err == nil {
+		return nil
+	}
+	hub := sentry.CurrentHub()
+	if ctx != nil {
+		if ctxHub := sentry.GetHubFromContext(ctx); ctxHub != nil {
+			hub = ctxHub
+		}
+	}
+	if hub.Client() == nil {
+		return nil
+	}
+
+	event := NewReportEvent(err)
+	code := event.Tags[ReportTagErrorCode]
+	if !r.sampled(code) || r.isDuplicated(event.Fingerprint) {
+		return nil
+	}
+	return hub.CaptureEvent(event)
+}
+
+func (r *Reporter) sampled(code string) bool {
+	rate, ok := r.opts.SampleRates[code]
+	if !ok {
+		rate = r.opts.DefaultSampleRate
+	}
+	return rate >= 1 || rand.Float64() < rate
+}
+
+func (r *Reporter) isDuplicated(fingerprint []string) bool {
+	if r.opts.DedupeInterval <= 0 {
+		return false
+	}
+	var (
+		key = ""
+		now = time.Now()
+	)
+	for _, part := range fingerprint {
+		key += part + "|"
+	}
+	r.dedupeMux.Lock()
+	defer r.dedupeMux.Unlock()
+	if lastTime, ok := r.dedupeTimes[key]; ok && now.Sub(lastTime) < r.opts.DedupeInterval {
+		return true
+	}
+	r.dedupeTimes[key] = now
+	for otherKey, otherTime := range r.dedupeTimes {
+		if now.Sub(otherTime) >= r.opts.DedupeInterval {
+			delete(r.dedupeTimes, otherKey)
+		}
+	}
+	return false
+}
+
+// NewReportEvent builds an event with one exception per layer of the chain (the innermost first),
+// Data() of every layer as extras, the business code as tag and fingerprint by code and calling function.
+// The line number isn't in the fingerprint so editing the code doesn't split existing issues.
+// The level follows erroy.GetSeverity.
+func NewReportEvent(err error) *sentry.Event {
+	var (
+		event      = sentry.NewEvent()
+		layers     = erroy.Layers(err)
+		code       string
+		function   string
+		exceptions = make([]sentry.Exception, 0, len(layers))
+	)
+	event.Level = reportLevel(erroy.GetSeverity(err))
+	event.Message = err.Error()
+
+	for i := len(layers) - 1; i >= 0; i-- {
+		layer := layers[i]
+		exception := sentry.Exception{
+			Type:  reflect.TypeOf(layer).String(),
+			Value: layer.Error(),
+		}
+		if ourErr, ok := layer.(erroy.Error); ok {
+			exception.Value = ourErr.RawError()
+			exception.Stacktrace = NewStacktrace(ourErr.Stacktrace())
+			for key, value := range ourErr.Data() {
+				event.Extra[key] = erroy.RedactFieldValue(key, value)
+			}
+		}
+		if coder, ok := layer.(erroy.Coder); ok && code == "" {
+			code = coder.ErrorCode()
+		}
+		exceptions = append(exceptions, exception)
+	}
+	// The outermost stacktrace is the nearest call site to the reporter.
+	for i := len(exceptions) - 1; i >= 0 && function == ""; i-- {
+		function = stacktraceFunction(exceptions[i].Stacktrace)
+	}
+	event.Exception = exceptions
+
+	if code != "" {
+		event.Tags[ReportTagErrorCode] = code
+		event.Fingerprint = []string{code}
+	} else {
+		event.Fingerprint = []string{reportFingerprintNoCode}
+	}
+	if function != "" {
+		event.Fingerprint = append(event.Fingerprint, function)
+	}
+	return event
+}
+
+func reportLevel(severity erroy.Severity) sentry.Level {
+	switch severity {
+	case erroy.SeverityDebug:
+		return sentry.LevelDebug
+	case erroy.SeverityInfo:
+		return sentry.LevelInfo
+	case erroy.SeverityWarning:
+		return sentry.LevelWarning
+	case erroy.SeverityFatal:
+		return sentry.LevelFatal
+	default:
+		return sentry.LevelError
+	}
+}
+
+func stacktraceFunction(stacktrace *sentry.Stacktrace) string {
+	if stacktrace == nil || len(stacktrace.Frames) == 0 {
+		return ""
+	}
+	frame := stacktrace.Frames[len(stacktrace.Frames)-1]
+	if frame.Module == "" {
+		return frame.Function
+	}
+	return frame.Module + "." + frame.Function
+}
@@ -0,0 +1,135 @@
+package errsentry_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+	"github.com/kiyuu10/common-lib-go/erroy/errsentry"
+	"github.com/kiyuu10/common-lib-go/gmeta"
+)
+
+const testErrorCode gmeta.ErrorCode = "error_test_report"
+
+func newTestContext(t *testing.T) (context.Context, *errsentry.MemoryTransport) {
+	t.Helper()
+	transport := errsentry.NewMemoryTransport()
+	client, err := sentry.NewClient(sentry.ClientOptions{Transport: transport})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hub := sentry.NewHub(client, sentry.NewScope())
+	return sentry.SetHubOnContext(context.Background(), hub), transport
+}
+
+func newTestError() error {
+	cause := erroy.WrapStack(errors.New("connection lost"), "query user").
+		WithField("user_id", 7).
+		WithField("password", "hunter2")
+	return gmeta.NewOurError(testErrorCode).Wrap(cause)
+}
+
+func TestReport(t *testing.T) {
+	ctx, transport := newTestContext(t)
+	reporter := errsentry.NewReporter(errsentry.ReportOptions{DefaultSampleRate: 1})
+	if eventID := reporter.Report(ctx, newTestError()); eventID == nil {
+		t.Fatal("event isn't captured")
+	}
+
+	events := transport.Events()
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+	event := events[0]
+
+	wantTypes := []string{"*errors.errorString", "*fmt.wrapError", "erroy.tError", "gmeta.OurError"}
+	if len(event.Exception) != len(wantTypes) {
+		t.Fatalf("got %d exceptions, want %d", len(event.Exception), len(wantTypes))
+	}
+	for i, wantType := range wantTypes {
+		if event.Exception[i].Type != wantType {
+			t.Errorf("exception %d type is %s, want %s", i, event.Exception[i].Type, wantType)
+		}
+	}
+	if event.Exception[0].Value != "connection lost" {
+		t.Errorf("innermost exception value is %q", event.Exception[0].Value)
+	}
+	if event.Exception[2].Stacktrace == nil || len(event.Exception[2].Stacktrace.Frames) == 0 {
+		t.Error("stacktrace of WrapStack is missing")
+	}
+
+	if code := event.Tags[errsentry.ReportTagErrorCode]; code != testErrorCode.String() {
+		t.Errorf("code tag is %q, want %q", code, testErrorCode)
+	}
+	wantFingerprint := []string{testErrorCode.String(), "github.com/kiyuu10/common-lib-go/erroy/errsentry_test.newTestError"}
+	if !slices.Equal(event.Fingerprint, wantFingerprint) {
+		t.Errorf("fingerprint is %v, want %v", event.Fingerprint, wantFingerprint)
+	}
+	if event.Level != sentry.LevelError {
+		t.Errorf("level is %s, want %s", event.Level, sentry.LevelError)
+	}
+	if event.Extra["user_id"] != 7 || event.Extra["password"] != erroy.RedactedValue {
+		t.Errorf("unexpected extras %v", event.Extra)
+	}
+}
+
+func TestReportLevel(t *testing.T) {
+	ctx, transport := newTestContext(t)
+	reporter := errsentry.NewReporter(errsentry.ReportOptions{DefaultSampleRate: 1})
+	reporter.Report(ctx, erroy.Wrap(newTestError()).WithSeverity(erroy.SeverityWarning))
+
+	events := transport.Events()
+	if len(events) != 1 || events[0].Level != sentry.LevelWarning {
+		t.Fatalf("warning isn't reported with the warning level: %v", events)
+	}
+}
+
+func TestReportSampling(t *testing.T) {
+	ctx, transport := newTestContext(t)
+	reporter := errsentry.NewReporter(errsentry.ReportOptions{
+		SampleRates:       map[string]float64{testErrorCode.String(): 0},
+		DefaultSampleRate: 1,
+	})
+	if eventID := reporter.Report(ctx, newTestError()); eventID != nil {
+		t.Error("event of a code sampled at 0 is captured")
+	}
+	if eventID := reporter.Report(ctx, errors.New("other")); eventID == nil {
+		t.Error("event of the default sample rate isn't captured")
+	}
+	if events := transport.Events(); len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+}
+
+func TestInstall(t *testing.T) {
+	ctx, transport := newTestContext(t)
+	errsentry.Install()
+	erroy.Report(ctx, newTestError())
+	if events := transport.Events(); len(events) != 1 {
+		t.Fatalf("got %d events through erroy.Report, want 1", len(events))
+	}
+}
+
+// Errors of the same code and function are grouped even if they're created on different lines.
+func TestNewReportEventFingerprintIgnoresLine(t *testing.T) {
+	newErrors := func() []error {
+		return []error{
+			gmeta.NewOurError(testErrorCode).Wrap(erroy.NewWithStack("first")),
+			gmeta.NewOurError(testErrorCode).Wrap(erroy.NewWithStack("second")),
+		}
+	}
+	errs := newErrors()
+	first, second := errsentry.NewReportEvent(errs[0]), errsentry.NewReportEvent(errs[1])
+	if len(first.Fingerprint) != 2 || !slices.Equal(first.Fingerprint, second.Fingerprint) {
+		t.Fatalf("fingerprints are %v and %v, want equal", first.Fingerprint, second.Fingerprint)
+	}
+
+	other := errsentry.NewReportEvent(gmeta.NewOurError(testErrorCode).Wrap(erroy.NewWithStack("other")))
+	if slices.Equal(first.Fingerprint, other.Fingerprint) {
+		t.Fatalf("errors of other functions share the fingerprint %v", first.Fingerprint)
+	}
+}
@@ -0,0 +1,31 @@
+package errsentry
+
+import (
+	"runtime"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+// NewStacktrace converts the stack, Sentry frames are ordered from the outermost call.
+func NewStacktrace(stack erroy.Stack) *sentry.Stacktrace {
+	frames := stack.Frames()
+	if len(frames) == 0 {
+		return nil
+	}
+	sentryFrames := make([]sentry.Frame, len(frames))
+	for i, frame := range frames {
+		sentryFrames[len(frames)-1-i] = sentry.NewFrame(runtime.Frame{
+			PC:       frame.PC,
+			Function: frame.Function,
+			File:     frame.File,
+			Line:     frame.Line,
+		})
+	}
+	return &sentry.Stacktrace{Frames: sentryFrames}
+}
+
+func IsEntryEnabled() bool {
+	return sentry.CurrentHub().Client() != nil
+}
@@ -0,0 +1,46 @@
+package errsentry
+
+import (
+	"sync"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// MemoryTransport keeps events in memory instead of sending, it's used for tests and local development.
+type MemoryTransport struct {
+	mux    sync.Mutex
+	events []*sentry.Event
+}
+
+var _ sentry.Transport = (*MemoryTransport)(nil)
+
+func NewMemoryTransport() *MemoryTransport {
+	return new(MemoryTransport)
+}
+
+func (t *MemoryTransport) Configure(sentry.ClientOptions) {}
+
+func (t *MemoryTransport) Flush(time.Duration) bool {
+	return true
+}
+
+func (t *MemoryTransport) SendEvent(event *sentry.Event) {
+	t.mux.Lock()
+	t.events = append(t.events, event)
+	t.mux.Unlock()
+}
+
+func (t *MemoryTransport) Events() []*sentry.Event {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	events := make([]*sentry.Event, len(t.events))
+	copy(events, t.events)
+	return events
+}
+
+func (t *MemoryTransport) Reset() {
+	t.mux.Lock()
+	t.events = nil
+	t.mux.Unlock()
+}
@@ -0,0 +1,131 @@
+package erroy
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+const testGoroutines = 32
+
+var (
+	errTestSentinel = New("test sentinel")
+	// errTestStdSentinel is how packages declare sentinels matched by errors.Is,
+	// an erroy error isn't comparable once it has data or a stack.
+	errTestStdSentinel = errors.New("test std sentinel")
+)
+
+func runConcurrently(t *testing.T, fn func(i int)) {
+	t.Helper()
+	var wg sync.WaitGroup
+	for i := 0; i < testGoroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestSentinelReuse(t *testing.T) {
+	runConcurrently(t, func(i int) {
+		key := "key_" + strconv.Itoa(i)
+		err := errTestSentinel.
+			WithField(key, i).
+			WithFields(map[string]any{"index": i})
+		data := err.Data()
+		if len(data) != 2 || data[key] != i || data["index"] != i {
+			t.Errorf("goroutine %d: unexpected data %v", i, data)
+		}
+	})
+	if data := errTestSentinel.Data(); len(data) != 0 {
+		t.Fatalf("sentinel is modified: %v", data)
+	}
+}
+
+func TestStdSentinelReuse(t *testing.T) {
+	runConcurrently(t, func(i int) {
+		err := Wrap(errTestStdSentinel).WithField("index", i)
+		if !errors.Is(err, errTestStdSentinel) {
+			t.Errorf("goroutine %d: decorated error doesn't match the sentinel", i)
+		}
+		if err.RawError() != errTestStdSentinel.Error() {
+			t.Errorf("goroutine %d: unexpected message %q", i, err.RawError())
+		}
+	})
+}
+
+func TestSharedErrorDecoration(t *testing.T) {
+	shared := errTestSentinel.WithField("shared", true)
+	runConcurrently(t, func(i int) {
+		err := shared.WithField("index", i)
+		_ = err.Error()
+		_ = err.Data()
+		if value, ok := FindData(err, "shared"); !ok || value != true {
+			t.Errorf("goroutine %d: shared field is lost", i)
+		}
+	})
+	if data := shared.Data(); len(data) != 1 {
+		t.Fatalf("shared error is modified: %v", data)
+	}
+}
+
+func TestDataIsCopied(t *testing.T) {
+	err := New("test").WithField("key", "value")
+	data := err.Data()
+	data["key"] = "changed"
+	if value, _ := FindData(err, "key"); value != "value" {
+		t.Fatalf("data of the error is modified through Data: %v", value)
+	}
+}
+
+func TestWrapIdempotency(t *testing.T) {
+	base := NewWithStack("test").WithField("key", "value")
+	runConcurrently(t, func(i int) {
+		wrapped := Wrap(Wrap(base))
+		if len(Layers(wrapped)) != len(Layers(base)) {
+			t.Errorf("goroutine %d: Wrap adds a layer to an erroy error", i)
+		}
+		if len(wrapped.Stacktrace()) != len(base.Stacktrace()) {
+			t.Errorf("goroutine %d: Wrap changes the stack", i)
+		}
+		_ = wrapped.WithField("index", i)
+	})
+	if data := base.Data(); len(data) != 1 {
+		t.Fatalf("wrapped error is modified: %v", data)
+	}
+}
+
+func TestWrapStackOnWrappedError(t *testing.T) {
+	base := WrapStack(errors.New("root"), "first")
+	baseStack := base.Stacktrace()
+	runConcurrently(t, func(i int) {
+		err := WrapStack(base, "second %d", i).WithField("index", i)
+		if len(err.Stacktrace()) != 0 {
+			t.Errorf("goroutine %d: stack is captured again", i)
+		}
+		if want := fmt.Sprintf("second %d: first: root", i); err.RawError() != want {
+			t.Errorf("goroutine %d: message %q, want %q", i, err.RawError(), want)
+		}
+		var inner Error
+		if !errors.As(errors.Unwrap(err), &inner) || len(inner.Stacktrace()) != len(baseStack) {
+			t.Errorf("goroutine %d: inner stack is lost", i)
+		}
+	})
+}
+
+func TestWrapStackOnForeignError(t *testing.T) {
+	root := errors.New("root")
+	runConcurrently(t, func(i int) {
+		err := WrapStack(fmt.Errorf("wrapped: %w", root), "message")
+		if len(err.Stacktrace()) == 0 {
+			t.Errorf("goroutine %d: stack isn't captured", i)
+		}
+		if !errors.Is(err, root) {
+			t.Errorf("goroutine %d: root is lost", i)
+		}
+	})
+}
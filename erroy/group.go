@@ -0,0 +1,127 @@
+package erroy
+
+import (
+	"context"
+	"sync"
+)
+
+// FieldGroupTask is the key of the failed task in the first error of a Group.
+const FieldGroupTask = "group_task"
+
+type GroupOptions struct {
+	// Limit of running goroutines, non-positive value means unlimited.
+	Limit int
+	// CancelOnError cancels the group context on the first error and Wait returns only that error
+	// having FieldGroupTask if the task is keyed, otherwise Wait returns all errors as a MultiError.
+	CancelOnError bool
+}
+
+// Group is like errgroup but panics of goroutines are recovered into errors and reported.
+type Group struct {
+	opts   GroupOptions
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+	sem    chan struct{}
+
+	errOnce  sync.Once
+	firstErr error
+	multiErr *MultiError
+}
+
+func NewGroup(ctx context.Context, opts GroupOptions) (*Group, context.Context) {
+	group := &Group{
+		opts:     opts,
+		multiErr: NewMultiError(),
+	}
+	group.ctx, group.cancel = context.WithCancelCause(ctx)
+	if opts.Limit > 0 {
+		group.sem = make(chan struct{}, opts.Limit)
+	}
+	return group, group.ctx
+}
+
+// Go blocks while the limit is reached, use GoKey to identify the task in the error.
+func (g *Group) Go(fn func(ctx context.Context) error) {
+	g.GoKey("", fn)
+}
+
+// GoKey is like Go, the error of fn is keyed by `key` in the MultiError returned by Wait.
+func (g *Group) GoKey(key string, fn func(ctx context.Context) error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	g.start(key, fn)
+}
+
+// TryGo starts fn only if the limit isn't reached.
+func (g *Group) TryGo(fn func(ctx context.Context) error) bool {
+	return g.TryGoKey("", fn)
+}
+
+func (g *Group) TryGoKey(key string, fn func(ctx context.Context) error) bool {
+	if g.sem != nil {
+		select {
+		case g.sem <- struct{}{}:
+		default:
+			return false
+		}
+	}
+	g.start(key, fn)
+	return true
+}
+
+func (g *Group) start(key string, fn func(ctx context.Context) error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if g.sem != nil {
+			defer func() { <-g.sem }()
+		}
+		err := Safe(func() error {
+			return fn(g.ctx)
+		})
+		if err == nil {
+			return
+		}
+		g.multiErr.Add(key, err)
+		g.errOnce.Do(func() {
+			g.firstErr = err
+			if key != "" {
+				g.firstErr = Wrap(err).WithField(FieldGroupTask, key)
+			}
+			if g.opts.CancelOnError {
+				g.cancel(g.firstErr)
+			}
+		})
+	}()
+}
+
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel(context.Canceled)
+	if g.opts.CancelOnError {
+		return g.firstErr
+	}
+	return g.multiErr.ErrorOrNil()
+}
+
+// Safe calls fn and converts its panic into an error with stacktrace, the panic is also reported.
+func Safe(fn func() error) (err error) {
+	defer func() {
+		if panicErr := recoverPanic(recover(), "goroutine panic"); panicErr != nil {
+			err = panicErr
+		}
+	}()
+	return fn()
+}
+
+// Go runs fn in a goroutine which never crashes the process on panic.
+func Go(fn func()) {
+	go func() {
+		_ = Safe(func() error {
+			fn()
+			return nil
+		})
+	}()
+}
@@ -0,0 +1,114 @@
+package erroy
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGroupKeys(t *testing.T) {
+	var (
+		group, _ = NewGroup(context.Background(), GroupOptions{})
+		btcErr   = errors.New("btc failed")
+	)
+	group.GoKey("BTC", func(ctx context.Context) error { return btcErr })
+	group.GoKey("ETH", func(ctx context.Context) error { panic("eth crashed") })
+	group.GoKey("TRX", func(ctx context.Context) error { return nil })
+	group.Go(func(ctx context.Context) error { return errors.New("anonymous") })
+
+	var multiErr *MultiError
+	if err := group.Wait(); !errors.As(err, &multiErr) {
+		t.Fatalf("Wait = %v", err)
+	}
+	if keys := multiErr.Keys(); !slices.Equal(keys, []string{"", "BTC", "ETH"}) {
+		t.Fatalf("keys = %v", keys)
+	}
+	if !errors.Is(multiErr.Get("BTC"), btcErr) || multiErr.Get("TRX") != nil {
+		t.Fatalf("errors = %v", multiErr)
+	}
+	if panicErr := multiErr.Get("ETH"); panicErr == nil || len(DeepestStack(panicErr)) == 0 {
+		t.Fatalf("panic error = %v", panicErr)
+	}
+}
+
+func TestGroupCancelOnError(t *testing.T) {
+	var (
+		group, ctx = NewGroup(context.Background(), GroupOptions{CancelOnError: true})
+		firstErr   = errors.New("first")
+	)
+	group.GoKey("first", func(ctx context.Context) error { return firstErr })
+	group.GoKey("sibling", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	err := group.Wait()
+	if !errors.Is(err, firstErr) {
+		t.Fatalf("Wait = %v, want the first error", err)
+	}
+	if task, _ := FindData(err, FieldGroupTask); task != "first" {
+		t.Fatalf("%s = %v", FieldGroupTask, task)
+	}
+	if !errors.Is(context.Cause(ctx), firstErr) {
+		t.Fatalf("context cause = %v", context.Cause(ctx))
+	}
+}
+
+func TestGroupLimit(t *testing.T) {
+	var (
+		group, _ = NewGroup(context.Background(), GroupOptions{Limit: 2})
+		running  atomic.Int32
+		peak     atomic.Int32
+		release  = make(chan struct{})
+	)
+	task := func(ctx context.Context) error {
+		current := running.Add(1)
+		for {
+			previous := peak.Load()
+			if current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		<-release
+		running.Add(-1)
+		return nil
+	}
+	group.Go(task)
+	group.Go(task)
+	if group.TryGoKey("third", task) {
+		t.Fatal("TryGoKey must fail while the limit is reached")
+	}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+	group.Go(task)
+	if err := group.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if peak.Load() > 2 {
+		t.Fatalf("peak = %d, want at most 2", peak.Load())
+	}
+	if !group.TryGo(func(ctx context.Context) error { return nil }) {
+		t.Fatal("TryGo must succeed when the limit isn't reached")
+	}
+	_ = group.Wait()
+}
+
+func TestSafe(t *testing.T) {
+	if err := Safe(func() error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	panicErr := errors.New("boom")
+	if err := Safe(func() error { panic(panicErr) }); !errors.Is(err, panicErr) || len(DeepestStack(err)) == 0 {
+		t.Fatalf("Safe = %v", err)
+	}
+	done := make(chan struct{})
+	Go(func() {
+		defer close(done)
+		panic("recovered")
+	})
+	<-done
+}
@@ -3,36 +3,118 @@ package erroy
 import (
 	"errors"
 	"fmt"
+	"maps"
+)
 
-	"github.com/getsentry/sentry-go"
+const (
+	maxUnwrapDepth = 100
 )
 
-func NewStacktrace(frameOffset int) *sentry.Stacktrace {
-	frameOffset++
-	stacktrace := sentry.NewStacktrace()
-	if frameOffset > 0 && len(stacktrace.Frames) > frameOffset {
-		toFrameIdx := len(stacktrace.Frames) - frameOffset
-		stacktrace.Frames = stacktrace.Frames[:toFrameIdx]
+func wrapErrorMessage(err error, msg string) error {
+	if err == nil {
+		return errors.New(msg)
+	} else {
+		return fmt.Errorf("%s: %w", msg, err)
 	}
-	return stacktrace
 }
 
-func extractErrorStacktrace(err error, frameOffset int) *sentry.Stacktrace {
-	stacktrace := sentry.ExtractStacktrace(err)
-	if stacktrace != nil {
-		return stacktrace
+// Layers returns the chain from the outermost error, it follows Wrapper and Causer.
+func Layers(err error) []error {
+	var layers []error
+	for err != nil && len(layers) < maxUnwrapDepth {
+		layers = append(layers, err)
+		switch errT := err.(type) {
+		case tError:
+			err = errT.err
+		case Wrapper:
+			err = errT.Unwrap()
+		case Causer:
+			err = errT.Cause()
+		default:
+			err = nil
+		}
 	}
-	return NewStacktrace(frameOffset)
+	return layers
 }
 
-func IsEntryEnabled() bool {
-	return sentry.CurrentHub().Client() != nil
+// matchCauseChain applies match on the cause of every layer which is a Causer but not a Wrapper,
+// other layers are already reached by errors.Is and errors.As.
+func matchCauseChain(err error, match func(err error) bool) bool {
+	layers := Layers(err)
+	for i := 0; i < len(layers)-1; i++ {
+		if _, ok := layers[i].(Wrapper); ok {
+			continue
+		}
+		if _, ok := layers[i].(Causer); ok && match(layers[i+1]) {
+			return true
+		}
+	}
+	return false
 }
 
-func wrapErrorMessage(err error, msg string) error {
-	if err == nil {
-		return errors.New(msg)
-	} else {
-		return fmt.Errorf("%s: %w", msg, err)
+// Cause returns the innermost error of the chain.
+func Cause(err error) error {
+	layers := Layers(err)
+	if len(layers) == 0 {
+		return nil
+	}
+	return layers[len(layers)-1]
+}
+
+// Code returns the outermost business code of the chain, it's empty if there's no Coder.
+func Code(err error) string {
+	for _, layer := range Layers(err) {
+		if coder, ok := layer.(Coder); ok {
+			return coder.ErrorCode()
+		}
+	}
+	return ""
+}
+
+// FindData returns the field of the outermost erroy error having it.
+func FindData(err error, key string) (any, bool) {
+	for _, layer := range Layers(err) {
+		ourErr, ok := layer.(tError)
+		if !ok {
+			continue
+		}
+		if value, ok := ourErr.data[key]; ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// MergedData returns fields of every layer of the chain, outer layers override inner ones.
+func MergedData(err error) map[string]any {
+	var (
+		layers = Layers(err)
+		merged map[string]any
+	)
+	for i := len(layers) - 1; i >= 0; i-- {
+		dataLayer, ok := layers[i].(interface{ Data() map[string]any })
+		if !ok {
+			continue
+		}
+		if data := dataLayer.Data(); len(data) > 0 {
+			if merged == nil {
+				merged = make(map[string]any, len(data))
+			}
+			maps.Copy(merged, data)
+		}
+	}
+	return merged
+}
+
+// DeepestStack returns the stack of the innermost layer having one, it's the nearest to where the error happened.
+func DeepestStack(err error) Stack {
+	layers := Layers(err)
+	for i := len(layers) - 1; i >= 0; i-- {
+		if stackLayer, ok := layers[i].(interface{ Stacktrace() Stack }); ok {
+			if stack := stackLayer.Stacktrace(); len(stack) > 0 {
+				return stack
+			}
+		}
 	}
+	return nil
 }
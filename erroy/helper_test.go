@@ -0,0 +1,112 @@
+package erroy
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// tLegacyError only implements Causer like errors of github.com/pkg/errors v0.8.
+type tLegacyError struct {
+	msg   string
+	cause error
+}
+
+func (e tLegacyError) Error() string { return e.msg + ": " + e.cause.Error() }
+func (e tLegacyError) Cause() error  { return e.cause }
+
+type tCodedError struct{ code string }
+
+func (e tCodedError) Error() string     { return "coded " + e.code }
+func (e tCodedError) ErrorCode() string { return e.code }
+
+func TestChainHelpers(t *testing.T) {
+	var (
+		coded = tCodedError{code: "E_INNER"}
+		inner = WrapMessage(coded, "inner").WithField("order_id", 7)
+	)
+	tests := []struct {
+		name   string
+		err    error
+		layers int
+	}{
+		// inner is the erroy error, its message layer and the coded error.
+		{"erroy error", inner, 3},
+		{"wrapper", fmt.Errorf("outer: %w", inner), 4},
+		{"causer", tLegacyError{msg: "legacy", cause: inner}, 4},
+		{"causer of causer", tLegacyError{msg: "outer", cause: tLegacyError{msg: "legacy", cause: inner}}, 5},
+		{"wrapper of causer", fmt.Errorf("outer: %w", tLegacyError{msg: "legacy", cause: inner}), 5},
+		{"causer of wrapper", tLegacyError{msg: "legacy", cause: fmt.Errorf("outer: %w", inner)}, 5},
+		{"erroy of causer of wrapper", Wrap(tLegacyError{msg: "legacy", cause: fmt.Errorf("outer: %w", inner)}), 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layers := Layers(tt.err)
+			if len(layers) != tt.layers {
+				t.Fatalf("Layers = %d %v, want %d", len(layers), layers, tt.layers)
+			}
+			if cause := Cause(tt.err); cause != coded {
+				t.Fatalf("Cause = %v, want %v", cause, coded)
+			}
+			if code := Code(tt.err); code != coded.code {
+				t.Fatalf("Code = %q, want %q", code, coded.code)
+			}
+			if value, ok := FindData(tt.err, "order_id"); !ok || value != 7 {
+				t.Fatalf("FindData = %v, %v", value, ok)
+			}
+			if _, ok := FindData(tt.err, "missing"); ok {
+				t.Fatal("FindData must not find a missing field")
+			}
+			if data := MergedData(tt.err); data["order_id"] != 7 {
+				t.Fatalf("MergedData = %v", data)
+			}
+		})
+	}
+}
+
+func TestChainOuterLayerWins(t *testing.T) {
+	var (
+		inner = Wrap(tCodedError{code: "E_INNER"}).WithField("status", "inner")
+		outer = Wrap(tLegacyError{msg: "legacy", cause: inner}).WithField("status", "outer")
+	)
+	if value, _ := FindData(outer, "status"); value != "outer" {
+		t.Fatalf("FindData = %v, want the outer field", value)
+	}
+	coded := fmt.Errorf("outer: %w", tLegacyError{msg: "legacy", cause: tCodedError{code: "E_INNER"}})
+	if code := Code(tCodedError{code: "E_OUTER"}); code != "E_OUTER" {
+		t.Fatalf("Code = %q", code)
+	}
+	if code := Code(coded); code != "E_INNER" {
+		t.Fatalf("Code = %q", code)
+	}
+	if code := Code(errors.New("plain")); code != "" {
+		t.Fatalf("Code of an error without Coder = %q", code)
+	}
+	if Cause(nil) != nil || Layers(nil) != nil {
+		t.Fatal("Cause and Layers of nil must be nil")
+	}
+}
+
+func TestChainIsAsThroughCauser(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"causer", Wrap(tLegacyError{msg: "legacy", cause: sentinel})},
+		{"causer of wrapper", Wrap(tLegacyError{msg: "legacy", cause: fmt.Errorf("wrapped: %w", sentinel)})},
+		{"wrapper of causer", Wrap(fmt.Errorf("wrapped: %w", tLegacyError{msg: "legacy", cause: sentinel}))},
+		{"causer of erroy", Wrap(tLegacyError{msg: "legacy", cause: WrapMessage(sentinel, "inner")})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, sentinel) {
+				t.Fatal("errors.Is must follow Cause")
+			}
+			var legacy tLegacyError
+			if !errors.As(tt.err, &legacy) || legacy.msg != "legacy" {
+				t.Fatalf("errors.As = %+v", legacy)
+			}
+		})
+	}
+}
@@ -1,17 +1,22 @@
 package erroy
 
-import (
-	"github.com/getsentry/sentry-go"
-)
-
 type (
 	Wrapper interface {
 		Unwrap() error
 	}
 	// Causer is like `Wrapper` but an anti-pattern and used for legacy only.
+	// erroy errors still follow it in errors.Is, errors.As and the chain helpers.
 	Causer interface {
 		Cause() error
 	}
+	// Coder is implemented by errors carrying a business code such as gmeta.OurError.
+	Coder interface {
+		ErrorCode() string
+	}
+	// ExitCoder decides the process status returned by ExitCode.
+	ExitCoder interface {
+		ExitCode() int
+	}
 )
 
 type Error interface {
@@ -19,8 +24,12 @@ type Error interface {
 	Wrapper
 	RawError() string
 	FullError() string
-	Stacktrace() *sentry.Stacktrace
+	Stacktrace() Stack
 	Data() map[string]any
 	WithField(key string, value any) Error
 	WithFields(map[string]any) Error
+	WithRetryable(bool) Error
+	WithTemporary(bool) Error
+	WithUserFacing(bool) Error
+	WithSeverity(Severity) Error
 }
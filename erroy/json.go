@@ -0,0 +1,83 @@
+package erroy
+
+import (
+	"encoding/json"
+)
+
+type tErrorJson struct {
+	Message string            `json:"message"`
+	Error   string            `json:"error"`
+	Fields  map[string]string `json:"fields,omitempty"`
+	Frames  []tFrameJson      `json:"frames,omitempty"`
+}
+
+type tFrameJson struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+// MarshalErrorJson encodes message, redacted fields of all layers and frames (innermost first)
+// of the deepest stack of the error.
+func MarshalErrorJson(err Error) ([]byte, error) {
+	errJson := tErrorJson{
+		Message: err.RawError(),
+		Error:   err.FullError(),
+	}
+	if data := MergedData(err); len(data) > 0 {
+		errJson.Fields = make(map[string]string, len(data))
+		for key, value := range data {
+			errJson.Fields[key] = FormatFieldValue(key, value)
+		}
+	}
+	if frames := DeepestStack(err).Frames(); len(frames) > 0 {
+		errJson.Frames = make([]tFrameJson, len(frames))
+		for i, frame := range frames {
+			errJson.Frames[i] = tFrameJson{
+				Function: frame.Function,
+				File:     frame.File,
+				Line:     frame.Line,
+			}
+		}
+	}
+	return json.Marshal(errJson)
+}
+
+func (e tError) MarshalJSON() ([]byte, error) {
+	return MarshalErrorJson(e)
+}
+
+type tMultiErrorJson struct {
+	Message string                `json:"message"`
+	Errors  []tMultiErrorItemJson `json:"errors"`
+}
+
+type tMultiErrorItemJson struct {
+	Key   string          `json:"key,omitempty"`
+	Error json.RawMessage `json:"error"`
+}
+
+// MarshalJSON encodes every child like MarshalErrorJson, foreign errors only have their message.
+func (m *MultiError) MarshalJSON() ([]byte, error) {
+	items := m.Items()
+	multiErrJson := tMultiErrorJson{
+		Message: m.RawError(),
+		Errors:  make([]tMultiErrorItemJson, len(items)),
+	}
+	for i, item := range items {
+		var (
+			data []byte
+			err  error
+		)
+		if ourErr, ok := item.Err.(Error); ok {
+			data, err = MarshalErrorJson(ourErr)
+		} else {
+			data, err = json.Marshal(tErrorJson{Message: item.Err.Error(), Error: item.Err.Error()})
+		}
+		if err != nil {
+			return nil, err
+		}
+		multiErrJson.Errors[i] = tMultiErrorItemJson{Key: item.Key, Error: data}
+	}
+	return json.Marshal(multiErrJson)
+}
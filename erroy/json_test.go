@@ -0,0 +1,34 @@
+package erroy
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMarshalErrorJson(t *testing.T) {
+	inner := WrapStack(errors.New("root"), "inner").WithField("inner_key", 1)
+	outer := WrapStack(inner, "outer").WithField("outer_key", "value").WithField("password", "hunter2")
+
+	data, err := MarshalErrorJson(outer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var errJson tErrorJson
+	if err := json.Unmarshal(data, &errJson); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(errJson.Message, "outer: inner: root") {
+		t.Errorf("message is %q", errJson.Message)
+	}
+	wantFields := map[string]string{"inner_key": "1", "outer_key": "value", "password": RedactedValue}
+	for key, want := range wantFields {
+		if errJson.Fields[key] != want {
+			t.Errorf("field %s is %q, want %q", key, errJson.Fields[key], want)
+		}
+	}
+	if len(errJson.Frames) == 0 || errJson.Frames[0].Function != "github.com/kiyuu10/common-lib-go/erroy.TestMarshalErrorJson" {
+		t.Errorf("frames of the inner stack are missing: %v", errJson.Frames)
+	}
+}
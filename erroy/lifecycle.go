@@ -0,0 +1,287 @@
+package erroy
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrShutdownHookTimeout = errors.New("shutdown hook: timeout")
+	ErrShutdownHookCycle   = errors.New("shutdown hook: dependency cycle")
+)
+
+type ShutdownHook struct {
+	Name string
+	Func func(ctx context.Context) error
+	// Priority is compared first, a higher priority hook runs earlier.
+	Priority int
+	// DependsOn are names of hooks this hook uses, they are shut down after this hook.
+	DependsOn []string
+	// Timeout overrides LifecycleOptions.HookTimeout.
+	Timeout time.Duration
+}
+
+type LifecycleOptions struct {
+	Timeout     time.Duration
+	HookTimeout time.Duration
+	// Signals default to SIGINT and SIGTERM.
+	Signals []os.Signal
+}
+
+// Lifecycle runs shutdown hooks in reverse registration order, ordered by priorities and dependencies,
+// then the root defers.
+type Lifecycle struct {
+	opts LifecycleOptions
+
+	hooksMux sync.Mutex
+	hooks    []ShutdownHook
+
+	shutdownOnce sync.Once
+	shutdownErr  error
+}
+
+var vRootLifecycle = NewLifecycle(LifecycleOptions{
+	Timeout:     30 * time.Second,
+	HookTimeout: 10 * time.Second,
+})
+
+func NewLifecycle(opts LifecycleOptions) *Lifecycle {
+	if len(opts.Signals) == 0 {
+		opts.Signals = []os.Signal{syscall.SIGINT, syscall.SIGTERM}
+	}
+	return &Lifecycle{opts: opts}
+}
+
+func RootLifecycle() *Lifecycle {
+	return vRootLifecycle
+}
+
+func RegisterShutdownHook(hook ShutdownHook) {
+	vRootLifecycle.Register(hook)
+}
+
+func (l *Lifecycle) Register(hook ShutdownHook) {
+	l.hooksMux.Lock()
+	l.hooks = append(l.hooks, hook)
+	l.hooksMux.Unlock()
+}
+
+// Run executes `fn` with a context canceled on signals then shuts down,
+// the returned error aggregates the error of `fn` and the shutdown.
+func (l *Lifecycle) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	signalCtx, stop := signal.NotifyContext(ctx, l.opts.Signals...)
+	defer stop()
+
+	var runErr error
+	func() {
+		defer func() {
+			if panicErr := recoverPanic(recover(), "lifecycle: run panic"); panicErr != nil {
+				runErr = panicErr
+			}
+		}()
+		runErr = fn(signalCtx)
+	}()
+	if errors.Is(runErr, context.Canceled) && signalCtx.Err() != nil && ctx.Err() == nil {
+		runErr = nil
+	}
+	return errors.Join(runErr, l.Shutdown(context.WithoutCancel(ctx)))
+}
+
+// Wait blocks until a signal is received or ctx is done, then shuts down.
+func (l *Lifecycle) Wait(ctx context.Context) error {
+	return l.Run(ctx, func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	})
+}
+
+// Shutdown is executed once, later calls return the same result.
+func (l *Lifecycle) Shutdown(ctx context.Context) error {
+	l.shutdownOnce.Do(func() {
+		l.shutdownErr = l.shutdown(ctx)
+	})
+	return l.shutdownErr
+}
+
+func (l *Lifecycle) shutdown(ctx context.Context) error {
+	if l.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.opts.Timeout)
+		defer cancel()
+	}
+	hooks, err := l.orderedHooks()
+	if err != nil {
+		return err
+	}
+	multiErr := NewMultiError()
+	for _, hook := range hooks {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			multiErr.Add(hook.Name, WrapMessage(ctxErr, "shutdown hook: skipped").WithField("hook", hook.Name))
+			continue
+		}
+		multiErr.Add(hook.Name, l.runHook(ctx, hook))
+	}
+	if l == vRootLifecycle {
+		ExecuteRootDefers()
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func (l *Lifecycle) runHook(ctx context.Context, hook ShutdownHook) error {
+	timeout := hook.Timeout
+	if timeout <= 0 {
+		timeout = l.opts.HookTimeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	doneCh := make(chan error, 1)
+	go func() {
+		var err error
+		defer func() {
+			if panicErr := recoverPanic(recover(), "shutdown hook: panic"); panicErr != nil {
+				err = panicErr
+			}
+			doneCh <- err
+		}()
+		err = hook.Func(ctx)
+	}()
+
+	select {
+	case err := <-doneCh:
+		if err != nil {
+			return WrapMessage(err, "shutdown hook: failed").WithField("hook", hook.Name)
+		}
+		return nil
+	case <-ctx.Done():
+		return WrapMessage(ErrShutdownHookTimeout, "shutdown hook: wait").WithField("hook", hook.Name)
+	}
+}
+
+// orderedHooks sorts by priority (desc) then reverse registration,
+// and moves every hook before the hooks it depends on.
+func (l *Lifecycle) orderedHooks() ([]ShutdownHook, error) {
+	l.hooksMux.Lock()
+	hooks := make([]ShutdownHook, len(l.hooks))
+	for i, hook := range l.hooks {
+		hooks[len(l.hooks)-1-i] = hook
+	}
+	l.hooksMux.Unlock()
+	sort.SliceStable(hooks, func(i, j int) bool {
+		return hooks[i].Priority > hooks[j].Priority
+	})
+
+	var (
+		indexes  = make(map[string]int, len(hooks))
+		blockers = make([]int, len(hooks)) // number of hooks must run before
+		users    = make([][]int, len(hooks))
+	)
+	for i, hook := range hooks {
+		if hook.Name != "" {
+			indexes[hook.Name] = i
+		}
+	}
+	for i, hook := range hooks {
+		for _, dependency := range hook.DependsOn {
+			if j, ok := indexes[dependency]; ok {
+				blockers[j]++
+				users[i] = append(users[i], j)
+			}
+		}
+	}
+
+	var (
+		ordered = make([]ShutdownHook, 0, len(hooks))
+		done    = make([]bool, len(hooks))
+	)
+	for len(ordered) < len(hooks) {
+		progressed := false
+		for i := range hooks {
+			if done[i] || blockers[i] > 0 {
+				continue
+			}
+			done[i] = true
+			progressed = true
+			ordered = append(ordered, hooks[i])
+			for _, j := range users[i] {
+				blockers[j]--
+			}
+			break
+		}
+		if !progressed {
+			return nil, WrapStack(ErrShutdownHookCycle, "shutdown: order hooks")
+		}
+	}
+	return ordered, nil
+}
+
+// recoverPanic converts the recovered value to an error with stacktrace from the panic site and reports it.
+// It must be called directly by the deferred function.
+func recoverPanic(errObj any, msg string) Error {
+	if errObj == nil {
+		return nil
+	}
+	err, ok := errObj.(error)
+	if !ok {
+		err = New("%v", errObj)
+	}
+	ourErr := tError{
+		err:   wrapErrorMessage(err, msg),
+		stack: NewStack(3),
+	}
+	log.Printf("%s | err=%s\n", msg, ourErr.Error())
+	Report(context.Background(), ourErr)
+	return ourErr
+}
+
+const (
+	ExitCodeSuccess = 0
+	ExitCodeFailure = 1
+	// ExitCodeInterrupted is the shell status of a process stopped by SIGINT.
+	ExitCodeInterrupted = 130
+)
+
+// ExitCode returns the process status for the result of Lifecycle.Run:
+//   - ExitCodeSuccess if err is nil, Run returns nil when fn stops because of a signal.
+//   - the code of the first ExitCoder in the chain.
+//   - ExitCodeInterrupted if err is context.Canceled, e.g. the parent context of Run is canceled.
+//   - ExitCodeFailure otherwise.
+//
+// A joined error returns the first code of its members other than ExitCodeInterrupted,
+// so a failed shutdown hook isn't hidden by the cancellation.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitCodeSuccess
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		code := ExitCodeSuccess
+		for _, member := range joined.Unwrap() {
+			switch memberCode := ExitCode(member); memberCode {
+			case ExitCodeSuccess:
+			case ExitCodeInterrupted:
+				code = memberCode
+			default:
+				return memberCode
+			}
+		}
+		return code
+	}
+	var exitCoder ExitCoder
+	if errors.As(err, &exitCoder) {
+		return exitCoder.ExitCode()
+	}
+	if errors.Is(err, context.Canceled) {
+		return ExitCodeInterrupted
+	}
+	return ExitCodeFailure
+}
@@ -0,0 +1,173 @@
+package erroy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+type tExitCodeError struct{ code int }
+
+func (e tExitCodeError) Error() string { return fmt.Sprintf("exit %d", e.code) }
+func (e tExitCodeError) ExitCode() int { return e.code }
+
+func TestLifecycleHookOrder(t *testing.T) {
+	var (
+		mux   sync.Mutex
+		order []string
+	)
+	hook := func(name string, priority int, dependsOn ...string) ShutdownHook {
+		return ShutdownHook{
+			Name:      name,
+			Priority:  priority,
+			DependsOn: dependsOn,
+			Func: func(ctx context.Context) error {
+				mux.Lock()
+				order = append(order, name)
+				mux.Unlock()
+				return nil
+			},
+		}
+	}
+	tests := []struct {
+		name  string
+		hooks []ShutdownHook
+		order []string
+	}{
+		{"reverse registration", []ShutdownHook{hook("db", 0), hook("cache", 0), hook("server", 0)}, []string{"server", "cache", "db"}},
+		{"priority", []ShutdownHook{hook("db", 0), hook("server", 10), hook("cache", 5)}, []string{"server", "cache", "db"}},
+		// The server uses the db so it's shut down before the db even if registered earlier.
+		{"dependency", []ShutdownHook{hook("server", 0, "db"), hook("db", 0), hook("metrics", 0)}, []string{"metrics", "server", "db"}},
+		{"unknown dependency", []ShutdownHook{hook("server", 0, "missing")}, []string{"server"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order = nil
+			lifecycle := NewLifecycle(LifecycleOptions{})
+			for _, hook := range tt.hooks {
+				lifecycle.Register(hook)
+			}
+			if err := lifecycle.Shutdown(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(order, tt.order) {
+				t.Fatalf("order = %v, want %v", order, tt.order)
+			}
+		})
+	}
+
+	lifecycle := NewLifecycle(LifecycleOptions{})
+	lifecycle.Register(hook("a", 0, "b"))
+	lifecycle.Register(hook("b", 0, "a"))
+	if err := lifecycle.Shutdown(context.Background()); !errors.Is(err, ErrShutdownHookCycle) {
+		t.Fatalf("Shutdown error = %v, want a cycle", err)
+	}
+}
+
+func TestLifecycleTimeout(t *testing.T) {
+	var (
+		lifecycle = NewLifecycle(LifecycleOptions{Timeout: 50 * time.Millisecond, HookTimeout: 10 * time.Millisecond})
+		blocking  = func(ctx context.Context) error {
+			time.Sleep(time.Second)
+			return nil
+		}
+		ran bool
+	)
+	lifecycle.Register(ShutdownHook{Name: "skipped", Func: func(ctx context.Context) error {
+		ran = true
+		return nil
+	}})
+	lifecycle.Register(ShutdownHook{Name: "overall", Func: blocking, Timeout: time.Second})
+	lifecycle.Register(ShutdownHook{Name: "hook", Func: blocking})
+
+	startedAt := time.Now()
+	err := lifecycle.Shutdown(context.Background())
+	if elapsed := time.Since(startedAt); elapsed > 500*time.Millisecond {
+		t.Fatalf("Shutdown takes %s", elapsed)
+	}
+	multiErr, ok := err.(*MultiError)
+	if !ok {
+		t.Fatalf("Shutdown error = %v", err)
+	}
+	if !errors.Is(multiErr.Get("hook"), ErrShutdownHookTimeout) || !errors.Is(multiErr.Get("overall"), ErrShutdownHookTimeout) {
+		t.Fatalf("hook errors = %v", err)
+	}
+	if ran || !errors.Is(multiErr.Get("skipped"), context.DeadlineExceeded) {
+		t.Fatalf("hook after the overall timeout ran %v, error = %v", ran, multiErr.Get("skipped"))
+	}
+}
+
+func TestLifecycleErrorAggregation(t *testing.T) {
+	var (
+		lifecycle = NewLifecycle(LifecycleOptions{})
+		closeErr  = errors.New("close failed")
+	)
+	lifecycle.Register(ShutdownHook{Name: "ok", Func: func(ctx context.Context) error { return nil }})
+	lifecycle.Register(ShutdownHook{Name: "failed", Func: func(ctx context.Context) error { return closeErr }})
+	lifecycle.Register(ShutdownHook{Name: "panic", Func: func(ctx context.Context) error { panic("boom") }})
+
+	runErr := errors.New("serve failed")
+	err := lifecycle.Run(context.Background(), func(ctx context.Context) error { return runErr })
+	if !errors.Is(err, runErr) || !errors.Is(err, closeErr) {
+		t.Fatalf("Run error = %v", err)
+	}
+	var multiErr *MultiError
+	if !errors.As(err, &multiErr) || multiErr.Len() != 2 || multiErr.Get("ok") != nil {
+		t.Fatalf("shutdown error = %v", multiErr)
+	}
+	if panicErr := multiErr.Get("panic"); panicErr == nil || len(DeepestStack(panicErr)) == 0 {
+		t.Fatalf("panic error = %v", multiErr.Get("panic"))
+	}
+	if again := lifecycle.Shutdown(context.Background()); again != multiErr {
+		t.Fatalf("second Shutdown = %v, want the first result", again)
+	}
+	if ExitCode(err) != ExitCodeFailure {
+		t.Fatalf("ExitCode = %d", ExitCode(err))
+	}
+}
+
+func TestLifecycleRunPanic(t *testing.T) {
+	err := NewLifecycle(LifecycleOptions{}).Run(context.Background(), func(ctx context.Context) error { panic("boom") })
+	if err == nil || ExitCode(err) != ExitCodeFailure {
+		t.Fatalf("Run error = %v", err)
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	hookErr := WrapMessage(ErrShutdownHookTimeout, "shutdown hook: wait")
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"nil", nil, ExitCodeSuccess},
+		{"failure", errors.New("crash"), ExitCodeFailure},
+		{"canceled", context.Canceled, ExitCodeInterrupted},
+		{"wrapped canceled", Wrap(context.Canceled).WithField("step", "serve"), ExitCodeInterrupted},
+		{"exit coder", Wrap(tExitCodeError{code: 3}), 3},
+		{"joined canceled and hook failure", errors.Join(context.Canceled, hookErr), ExitCodeFailure},
+		{"joined canceled and exit coder", errors.Join(context.Canceled, tExitCodeError{code: 4}), 4},
+		{"joined canceled", errors.Join(context.Canceled, nil), ExitCodeInterrupted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := ExitCode(tt.err); code != tt.code {
+				t.Fatalf("ExitCode(%v) = %d, want %d", tt.err, code, tt.code)
+			}
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := NewLifecycle(LifecycleOptions{}).Run(ctx, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if code := ExitCode(err); code != ExitCodeInterrupted {
+		t.Fatalf("ExitCode of a canceled Run = %d, error = %v", code, err)
+	}
+}
@@ -16,7 +16,7 @@ func newError(withStack bool, msg string, params ...any) Error {
 		err: msgErr,
 	}
 	if withStack {
-		err.stacktrace = extractErrorStacktrace(err, 3)
+		err.stack = NewStack(2)
 	}
 	return err
 }
@@ -48,7 +48,7 @@ func WrapMessage(err error, msg string, params ...any) Error {
 }
 
 // WrapStack includes stacktrace from the function call location.
-// It's consume lots of resources, should not use it for common errors.
+// Only program counters are captured, frames are resolved when they're printed or reported.
 // For now, the idempotency is applied on the stack.
 func WrapStack(err error, msg string, params ...any) Error {
 	if ourErr, ok := err.(tError); ok {
@@ -59,7 +59,7 @@ func WrapStack(err error, msg string, params ...any) Error {
 	}
 	err = wrapErrorMessage(err, msg)
 	return tError{
-		err:        err,
-		stacktrace: extractErrorStacktrace(err, 2),
+		err:   err,
+		stack: NewStack(1),
 	}
 }
@@ -0,0 +1,167 @@
+package erroy
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type MultiErrorItem struct {
+	Key string
+	Err error
+}
+
+// MultiError collects errors of a batch, it's safe for concurrent use.
+// It doesn't implement Error because it unwraps to many errors.
+type MultiError struct {
+	mux   sync.RWMutex
+	items []MultiErrorItem
+}
+
+func NewMultiError() *MultiError {
+	return new(MultiError)
+}
+
+// Add ignores nil errors so it can be called with any result.
+func (m *MultiError) Add(key string, err error) {
+	if err == nil {
+		return
+	}
+	m.mux.Lock()
+	m.items = append(m.items, MultiErrorItem{Key: key, Err: err})
+	m.mux.Unlock()
+}
+
+func (m *MultiError) Append(errs ...error) {
+	for _, err := range errs {
+		m.Add("", err)
+	}
+}
+
+func (m *MultiError) Len() int {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+	return len(m.items)
+}
+
+func (m *MultiError) Items() []MultiErrorItem {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+	items := make([]MultiErrorItem, len(m.items))
+	copy(items, m.items)
+	return items
+}
+
+// Get returns the first error of the key.
+func (m *MultiError) Get(key string) error {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+	for _, item := range m.items {
+		if item.Key == key {
+			return item.Err
+		}
+	}
+	return nil
+}
+
+// ErrorOrNil avoids returning a non-nil error interface holding an empty MultiError.
+func (m *MultiError) ErrorOrNil() error {
+	if m == nil || m.Len() == 0 {
+		return nil
+	}
+	return m
+}
+
+func (m *MultiError) Error() string {
+	return m.FullError()
+}
+
+func (m *MultiError) RawError() string {
+	return m.render(func(err error) string {
+		if ourErr, ok := err.(Error); ok {
+			return ourErr.RawError()
+		}
+		return err.Error()
+	})
+}
+
+func (m *MultiError) FullError() string {
+	return m.render(func(err error) string {
+		return err.Error()
+	})
+}
+
+func (m *MultiError) render(errorString func(err error) string) string {
+	items := m.Items()
+	if len(items) == 1 && items[0].Key == "" {
+		return errorString(items[0].Err)
+	}
+	var sb strings.Builder
+	sb.WriteString(strconv.Itoa(len(items)) + " errors occurred:")
+	for _, item := range items {
+		sb.WriteString("\n\t* ")
+		if item.Key != "" {
+			sb.WriteString("[" + item.Key + "] ")
+		}
+		sb.WriteString(errorString(item.Err))
+	}
+	return sb.String()
+}
+
+// Unwrap supports errors.Is and errors.As on every child.
+func (m *MultiError) Unwrap() []error {
+	items := m.Items()
+	errs := make([]error, len(items))
+	for i, item := range items {
+		errs[i] = item.Err
+	}
+	return errs
+}
+
+// Data merges fields of children, fields of keyed children are prefixed by `key.`.
+func (m *MultiError) Data() map[string]any {
+	data := make(map[string]any)
+	for _, item := range m.Items() {
+		ourErr, ok := item.Err.(Error)
+		if !ok {
+			continue
+		}
+		for field, value := range ourErr.Data() {
+			if item.Key != "" {
+				field = item.Key + "." + field
+			}
+			data[field] = value
+		}
+	}
+	return data
+}
+
+// Keys returns distinct keys in sorted order.
+func (m *MultiError) Keys() []string {
+	var (
+		items = m.Items()
+		seen  = make(map[string]struct{}, len(items))
+		keys  = make([]string, 0, len(items))
+	)
+	for _, item := range items {
+		if _, ok := seen[item.Key]; ok {
+			continue
+		}
+		seen[item.Key] = struct{}{}
+		keys = append(keys, item.Key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Retryable implements Retryabler, a batch is retryable only if all children are.
+func (m *MultiError) Retryable() bool {
+	items := m.Items()
+	for _, item := range items {
+		if !IsRetryable(item.Err) {
+			return false
+		}
+	}
+	return len(items) > 0
+}
@@ -0,0 +1,136 @@
+package erroy
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+type tCodeError struct{ code string }
+
+func (e tCodeError) Error() string { return "code " + e.code }
+
+func TestMultiErrorAdd(t *testing.T) {
+	multiErr := NewMultiError()
+	multiErr.Add("BTC", nil)
+	if multiErr.Len() != 0 || multiErr.ErrorOrNil() != nil {
+		t.Fatal("nil errors must be ignored")
+	}
+	var (
+		btcErr = errors.New("btc failed")
+		ethErr = New("eth failed").WithField("height", 10)
+	)
+	multiErr.Add("ETH", ethErr)
+	multiErr.Add("BTC", btcErr)
+	multiErr.Add("BTC", errors.New("btc failed again"))
+	multiErr.Append(nil, errors.New("anonymous"))
+
+	if multiErr.Len() != 4 {
+		t.Fatalf("Len = %d", multiErr.Len())
+	}
+	if multiErr.Get("BTC") != btcErr || multiErr.Get("TRX") != nil {
+		t.Fatalf("Get = %v, %v", multiErr.Get("BTC"), multiErr.Get("TRX"))
+	}
+	if keys := multiErr.Keys(); strings.Join(keys, ",") != ",BTC,ETH" {
+		t.Fatalf("Keys = %q", keys)
+	}
+	if data := multiErr.Data(); data["ETH.height"] != 10 || len(data) != 1 {
+		t.Fatalf("Data = %v", data)
+	}
+	wantMessage := "4 errors occurred:\n\t* [ETH] eth failed\n\t* [BTC] btc failed\n\t* [BTC] btc failed again\n\t* anonymous"
+	if message := multiErr.RawError(); message != wantMessage {
+		t.Fatalf("RawError = %q, want %q", message, wantMessage)
+	}
+	if !strings.Contains(multiErr.FullError(), "eth failed | height=10") {
+		t.Fatalf("FullError = %q", multiErr.FullError())
+	}
+
+	single := NewMultiError()
+	single.Append(btcErr)
+	if single.Error() != btcErr.Error() {
+		t.Fatalf("a single anonymous error renders %q", single.Error())
+	}
+}
+
+func TestMultiErrorIsAs(t *testing.T) {
+	multiErr := NewMultiError()
+	multiErr.Add("file", WrapMessage(fs.ErrNotExist, "open config"))
+	multiErr.Add("code", Wrap(tCodeError{code: "E1"}))
+	err := Wrap(multiErr.ErrorOrNil())
+
+	if !errors.Is(err, fs.ErrNotExist) || errors.Is(err, fs.ErrPermission) {
+		t.Fatal("errors.Is must match the children only")
+	}
+	var codeErr tCodeError
+	if !errors.As(err, &codeErr) || codeErr.code != "E1" {
+		t.Fatalf("errors.As = %v", codeErr)
+	}
+	var found *MultiError
+	if !errors.As(err, &found) || found != multiErr {
+		t.Fatal("errors.As must find the MultiError")
+	}
+}
+
+func TestMultiErrorErrorOrNil(t *testing.T) {
+	var nilMultiErr *MultiError
+	if nilMultiErr.ErrorOrNil() != nil || NewMultiError().ErrorOrNil() != nil {
+		t.Fatal("ErrorOrNil of nil or empty MultiError must be nil")
+	}
+	multiErr := NewMultiError()
+	multiErr.Append(errors.New("failed"))
+	if err := multiErr.ErrorOrNil(); err != multiErr {
+		t.Fatalf("ErrorOrNil = %v", err)
+	}
+}
+
+func TestMultiErrorRetryable(t *testing.T) {
+	multiErr := NewMultiError()
+	if multiErr.Retryable() {
+		t.Fatal("an empty MultiError isn't retryable")
+	}
+	multiErr.Add("a", New("a").WithRetryable(true))
+	if !IsRetryable(multiErr) {
+		t.Fatal("all retryable children make the batch retryable")
+	}
+	multiErr.Add("b", errors.New("b"))
+	if IsRetryable(multiErr) {
+		t.Fatal("a non-retryable child makes the batch non-retryable")
+	}
+}
+
+func TestMultiErrorJson(t *testing.T) {
+	multiErr := NewMultiError()
+	multiErr.Add("ETH", NewWithStack("eth failed").WithField("password", "secret"))
+	multiErr.Add("BTC", errors.New("btc failed"))
+
+	data, err := json.Marshal(multiErr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct {
+		Message string `json:"message"`
+		Errors  []struct {
+			Key   string `json:"key"`
+			Error struct {
+				Message string            `json:"message"`
+				Fields  map[string]string `json:"fields"`
+				Frames  []tFrameJson      `json:"frames"`
+			} `json:"error"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Message != multiErr.RawError() || len(decoded.Errors) != 2 {
+		t.Fatalf("json = %s", data)
+	}
+	eth, btc := decoded.Errors[0], decoded.Errors[1]
+	if eth.Key != "ETH" || eth.Error.Message != "eth failed" || eth.Error.Fields["password"] == "secret" || len(eth.Error.Frames) == 0 {
+		t.Fatalf("ETH json = %+v", eth)
+	}
+	if btc.Key != "BTC" || btc.Error.Message != "btc failed" || btc.Error.Fields != nil {
+		t.Fatalf("BTC json = %+v", btc)
+	}
+}
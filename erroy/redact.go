@@ -0,0 +1,126 @@
+package erroy
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	RedactedValue = "***"
+
+	DefaultFieldValueMaxLength = 256
+)
+
+var (
+	vRedactMux        sync.RWMutex
+	vRedactedFields   = map[string]struct{}{}
+	vRedactedTypes    []reflect.Type
+	vFieldValueMaxLen = DefaultFieldValueMaxLength
+)
+
+func init() {
+	RegisterRedactedField("password", "secret", "token", "private_key", "api_key", "authorization")
+}
+
+// RegisterRedactedField makes values of the fields always rendered as `***`, names are case-insensitive.
+// A key ending with a registered name is redacted too, e.g. `access_token` and `db_password`.
+func RegisterRedactedField(names ...string) {
+	vRedactMux.Lock()
+	defer vRedactMux.Unlock()
+	for _, name := range names {
+		vRedactedFields[strings.ToLower(name)] = struct{}{}
+	}
+}
+
+// RegisterRedactedType makes values of the types always rendered as `***`.
+// An interface type matches every value implementing it, a struct type matches its pointer too.
+func RegisterRedactedType(types ...reflect.Type) {
+	vRedactMux.Lock()
+	defer vRedactMux.Unlock()
+	vRedactedTypes = append(vRedactedTypes, types...)
+}
+
+// SetFieldValueMaxLength limits rendered value lengths, non-positive value disables truncation.
+func SetFieldValueMaxLength(maxLength int) {
+	vRedactMux.Lock()
+	defer vRedactMux.Unlock()
+	vFieldValueMaxLen = maxLength
+}
+
+func isRedacted(key string, value any) bool {
+	vRedactMux.RLock()
+	defer vRedactMux.RUnlock()
+	lowerKey := strings.ToLower(key)
+	for name := range vRedactedFields {
+		if strings.HasSuffix(lowerKey, name) {
+			return true
+		}
+	}
+	if value == nil {
+		return false
+	}
+	valueType := reflect.TypeOf(value)
+	for _, redactedType := range vRedactedTypes {
+		if valueType == redactedType {
+			return true
+		}
+		if redactedType.Kind() == reflect.Interface &&
+			(valueType.Implements(redactedType) || reflect.PointerTo(valueType).Implements(redactedType)) {
+			return true
+		}
+		if valueType.Kind() == reflect.Pointer && valueType.Elem() == redactedType {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactFieldValue keeps the value as is unless it's redacted, for structured outputs.
+func RedactFieldValue(key string, value any) any {
+	if isRedacted(key, value) {
+		return RedactedValue
+	}
+	return value
+}
+
+// FormatFieldValue renders a data value with redaction and truncation applied.
+func FormatFieldValue(key string, value any) string {
+	if isRedacted(key, value) {
+		return RedactedValue
+	}
+	valueStr := fmt.Sprintf("%+v", value)
+	vRedactMux.RLock()
+	maxLength := vFieldValueMaxLen
+	vRedactMux.RUnlock()
+	if maxLength > 0 && len(valueStr) > maxLength {
+		// Back off to a rune boundary so a multi-byte character isn't split.
+		for maxLength > 0 && !utf8.RuneStart(valueStr[maxLength]) {
+			maxLength--
+		}
+		valueStr = valueStr[:maxLength] + "...(truncated)"
+	}
+	return valueStr
+}
+
+// FormatData renders data as `key=value` pairs sorted by key.
+func FormatData(data map[string]any) string {
+	keys := sortedDataKeys(data)
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + FormatFieldValue(key, data[key])
+	}
+	return strings.Join(pairs, ",")
+}
+
+func sortedDataKeys(data map[string]any) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
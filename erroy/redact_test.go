@@ -0,0 +1,54 @@
+package erroy
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestFormatFieldValueRedaction(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"password", RedactedValue},
+		{"Password", RedactedValue},
+		{"user_password", RedactedValue},
+		{"access_token", RedactedValue},
+		{"refreshToken", RedactedValue},
+		{"db_secret", RedactedValue},
+		{"data.api_key", RedactedValue},
+		{"user_id", "value"},
+		{"tokens", "value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := FormatFieldValue(tt.key, "value"); got != tt.want {
+				t.Errorf("FormatFieldValue(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatFieldValueTruncation(t *testing.T) {
+	SetFieldValueMaxLength(4)
+	defer SetFieldValueMaxLength(DefaultFieldValueMaxLength)
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"abcd", "abcd"},
+		{"abcde", "abcd...(truncated)"},
+		{"abcđe", "abc...(truncated)"},
+		{"ab€", "ab...(truncated)"},
+		{"ab€€", "ab...(truncated)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got := FormatFieldValue("key", tt.value)
+			if got != tt.want || !utf8.ValidString(got) {
+				t.Errorf("FormatFieldValue(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package erroy
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// ReportFunc sends errors to an error tracker, erroy/errsentry.Install sets one for Sentry.
+type ReportFunc func(ctx context.Context, err error)
+
+var vReportFunc atomic.Pointer[ReportFunc]
+
+func SetReportFunc(reportFunc ReportFunc) {
+	vReportFunc.Store(&reportFunc)
+}
+
+// Report does nothing until a ReportFunc is set.
+func Report(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+	if reportFunc := vReportFunc.Load(); reportFunc != nil && *reportFunc != nil {
+		(*reportFunc)(ctx, err)
+	}
+}
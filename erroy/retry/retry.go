@@ -0,0 +1,135 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+const (
+	DefaultMaxAttempts  = 3
+	DefaultInitialDelay = 100 * time.Millisecond
+	DefaultMaxDelay     = 10 * time.Second
+	DefaultMultiplier   = 2
+
+	FieldAttempts  = "retry_attempts"
+	FieldLastDelay = "retry_last_delay"
+)
+
+type Attempt struct {
+	Number int
+	Err    error
+	// Delay is the wait before the next attempt, it can be zero with jitter, use Last to detect the final attempt.
+	Delay time.Duration
+	Last  bool
+}
+
+type Options struct {
+	// MaxAttempts includes the first call, non-positive value means unlimited when MaxElapsed is set.
+	MaxAttempts  int
+	MaxElapsed   time.Duration
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+	Multiplier   float64
+	// Jitter randomizes delays in [delay*(1-Jitter), delay*(1+Jitter)].
+	Jitter float64
+	// Retryable overrides erroy.IsRetryable.
+	Retryable func(err error) bool
+	OnAttempt func(ctx context.Context, attempt Attempt)
+}
+
+func DefaultOptions() Options {
+	return Options{
+		MaxAttempts:  DefaultMaxAttempts,
+		InitialDelay: DefaultInitialDelay,
+		MaxDelay:     DefaultMaxDelay,
+		Multiplier:   DefaultMultiplier,
+		Jitter:       0.2,
+	}
+}
+
+func (opts Options) normalize() Options {
+	if opts.MaxAttempts <= 0 && opts.MaxElapsed <= 0 {
+		opts.MaxAttempts = DefaultMaxAttempts
+	}
+	if opts.InitialDelay <= 0 {
+		opts.InitialDelay = DefaultInitialDelay
+	}
+	if opts.MaxDelay <= 0 {
+		opts.MaxDelay = DefaultMaxDelay
+	}
+	if opts.Multiplier < 1 {
+		opts.Multiplier = DefaultMultiplier
+	}
+	opts.Jitter = math.Min(math.Max(opts.Jitter, 0), 1)
+	if opts.Retryable == nil {
+		opts.Retryable = erroy.IsRetryable
+	}
+	return opts
+}
+
+// Backoff returns the delay before the attempt following `attempt`, starting from 1.
+func (opts Options) Backoff(attempt int) time.Duration {
+	opts = opts.normalize()
+	delay := float64(opts.InitialDelay) * math.Pow(opts.Multiplier, float64(attempt-1))
+	delay = math.Min(delay, float64(opts.MaxDelay))
+	if opts.Jitter > 0 {
+		delay *= 1 - opts.Jitter + 2*opts.Jitter*rand.Float64()
+	}
+	return time.Duration(delay)
+}
+
+func Do(ctx context.Context, opts Options, fn func(ctx context.Context) error) error {
+	_, err := DoValue(ctx, opts, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	})
+	return err
+}
+
+// DoValue calls fn until it succeeds, fails with a non-retryable error or the limits are reached.
+// The final error has the attempt count and the last delay as fields.
+func DoValue[T any](ctx context.Context, opts Options, fn func(ctx context.Context) (T, error)) (value T, err error) {
+	opts = opts.normalize()
+	var (
+		startedAt = time.Now()
+		lastDelay time.Duration
+		attempt   int
+	)
+	for {
+		attempt++
+		if value, err = fn(ctx); err == nil {
+			return value, nil
+		}
+		delay := opts.Backoff(attempt)
+		stop := !opts.Retryable(err) ||
+			(opts.MaxAttempts > 0 && attempt >= opts.MaxAttempts) ||
+			(opts.MaxElapsed > 0 && time.Since(startedAt)+delay > opts.MaxElapsed)
+		if stop {
+			delay = 0
+		}
+		if opts.OnAttempt != nil {
+			opts.OnAttempt(ctx, Attempt{Number: attempt, Err: err, Delay: delay, Last: stop})
+		}
+		if stop {
+			return value, finalError(err, attempt, lastDelay)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return value, finalError(erroy.WrapMessage(errors.Join(ctx.Err(), err), "retry: interrupted"), attempt, lastDelay)
+		case <-timer.C:
+		}
+		lastDelay = delay
+	}
+}
+
+func finalError(err error, attempts int, lastDelay time.Duration) error {
+	return erroy.Wrap(err).
+		WithField(FieldAttempts, attempts).
+		WithField(FieldLastDelay, lastDelay)
+}
@@ -0,0 +1,189 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+	"github.com/kiyuu10/common-lib-go/erroy/retry"
+)
+
+func testOptions() retry.Options {
+	return retry.Options{
+		MaxAttempts:  3,
+		InitialDelay: time.Millisecond,
+		MaxDelay:     5 * time.Millisecond,
+		Multiplier:   2,
+	}
+}
+
+func failing(calls *int, err error) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		*calls++
+		return err
+	}
+}
+
+func TestDoMaxAttempts(t *testing.T) {
+	var (
+		calls    int
+		attempts []retry.Attempt
+		opts     = testOptions()
+	)
+	opts.OnAttempt = func(ctx context.Context, attempt retry.Attempt) {
+		attempts = append(attempts, attempt)
+	}
+	retryableErr := erroy.New("unavailable").WithRetryable(true)
+	err := retry.Do(context.Background(), opts, failing(&calls, retryableErr))
+	if calls != 3 || err == nil {
+		t.Fatalf("calls = %d, error = %v", calls, err)
+	}
+	wantDelays := []time.Duration{time.Millisecond, 2 * time.Millisecond, 0}
+	if len(attempts) != len(wantDelays) {
+		t.Fatalf("attempts = %+v", attempts)
+	}
+	for i, attempt := range attempts {
+		if attempt.Number != i+1 || attempt.Delay != wantDelays[i] || attempt.Last != (i == 2) || attempt.Err == nil {
+			t.Fatalf("attempt %d = %+v", i, attempt)
+		}
+	}
+	if attemptsField, _ := erroy.FindData(err, retry.FieldAttempts); attemptsField != 3 {
+		t.Fatalf("%s = %v", retry.FieldAttempts, attemptsField)
+	}
+	if lastDelay, _ := erroy.FindData(err, retry.FieldLastDelay); lastDelay != 2*time.Millisecond {
+		t.Fatalf("%s = %v", retry.FieldLastDelay, lastDelay)
+	}
+	if erroy.Cause(err) != erroy.Cause(retryableErr) {
+		t.Fatalf("cause = %v", erroy.Cause(err))
+	}
+}
+
+func TestDoSucceeds(t *testing.T) {
+	calls := 0
+	value, err := retry.DoValue(context.Background(), testOptions(), func(ctx context.Context) (int, error) {
+		calls++
+		if calls < 2 {
+			return 0, context.DeadlineExceeded
+		}
+		return 42, nil
+	})
+	if err != nil || value != 42 || calls != 2 {
+		t.Fatalf("DoValue = %d, %v after %d calls", value, err, calls)
+	}
+}
+
+func TestDoNonRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"unclassified", errors.New("invalid")},
+		{"classified", erroy.New("invalid").WithRetryable(false)},
+		{"canceled", context.Canceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := retry.Do(context.Background(), testOptions(), failing(&calls, tt.err))
+			if calls != 1 || err == nil {
+				t.Fatalf("calls = %d, error = %v", calls, err)
+			}
+			if attempts, _ := erroy.FindData(err, retry.FieldAttempts); attempts != 1 {
+				t.Fatalf("%s = %v", retry.FieldAttempts, attempts)
+			}
+			if lastDelay, _ := erroy.FindData(err, retry.FieldLastDelay); lastDelay != time.Duration(0) {
+				t.Fatalf("%s = %v", retry.FieldLastDelay, lastDelay)
+			}
+		})
+	}
+}
+
+func TestDoRetryableOverride(t *testing.T) {
+	calls := 0
+	opts := testOptions()
+	opts.Retryable = func(err error) bool { return err.Error() == "busy" }
+	_ = retry.Do(context.Background(), opts, failing(&calls, errors.New("busy")))
+	if calls != 3 {
+		t.Fatalf("calls = %d", calls)
+	}
+}
+
+func TestDoMaxElapsed(t *testing.T) {
+	calls := 0
+	opts := testOptions()
+	opts.MaxAttempts = 0
+	opts.MaxElapsed = 20 * time.Millisecond
+	opts.InitialDelay = 5 * time.Millisecond
+	opts.MaxDelay = 5 * time.Millisecond
+	startedAt := time.Now()
+	err := retry.Do(context.Background(), opts, failing(&calls, context.DeadlineExceeded))
+	if elapsed := time.Since(startedAt); elapsed > opts.MaxElapsed {
+		t.Fatalf("elapsed %s exceeds %s", elapsed, opts.MaxElapsed)
+	}
+	if calls < 2 || calls > 4 || err == nil {
+		t.Fatalf("calls = %d, error = %v", calls, err)
+	}
+}
+
+func TestDoCanceledDuringDelay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	opts := testOptions()
+	opts.InitialDelay = time.Hour
+	opts.MaxDelay = time.Hour
+	opts.OnAttempt = func(ctx context.Context, attempt retry.Attempt) {
+		cancel()
+	}
+	startedAt := time.Now()
+	err := retry.Do(ctx, opts, failing(&calls, context.DeadlineExceeded))
+	if time.Since(startedAt) > time.Second || calls != 1 {
+		t.Fatalf("calls = %d after %s", calls, time.Since(startedAt))
+	}
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want both the cancellation and the last error", err)
+	}
+	if attempts, _ := erroy.FindData(err, retry.FieldAttempts); attempts != 1 {
+		t.Fatalf("%s = %v", retry.FieldAttempts, attempts)
+	}
+}
+
+// A jittered delay rounded to zero must not stop retrying.
+func TestDoZeroJitteredDelay(t *testing.T) {
+	calls := 0
+	opts := testOptions()
+	opts.MaxAttempts = 5
+	opts.InitialDelay = time.Nanosecond
+	opts.Jitter = 1
+	_ = retry.Do(context.Background(), opts, failing(&calls, context.DeadlineExceeded))
+	if calls != 5 {
+		t.Fatalf("calls = %d", calls)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	opts := testOptions()
+	tests := []struct {
+		attempt int
+		delay   time.Duration
+	}{
+		{1, time.Millisecond},
+		{2, 2 * time.Millisecond},
+		{3, 4 * time.Millisecond},
+		{4, 5 * time.Millisecond},
+		{10, 5 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		if delay := opts.Backoff(tt.attempt); delay != tt.delay {
+			t.Fatalf("Backoff(%d) = %s, want %s", tt.attempt, delay, tt.delay)
+		}
+	}
+
+	opts.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if delay := opts.Backoff(2); delay < time.Millisecond || delay > 3*time.Millisecond {
+			t.Fatalf("jittered Backoff(2) = %s", delay)
+		}
+	}
+}
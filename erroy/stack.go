@@ -0,0 +1,94 @@
+package erroy
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"runtime"
+	"strconv"
+)
+
+const (
+	maxStackDepth = 64
+)
+
+type Frame struct {
+	PC       uintptr
+	Function string
+	File     string
+	Line     int
+}
+
+// Format supports the following verbs:
+//
+//	%s    file base name
+//	%d    line number
+//	%n    function name
+//	%v    equivalent to %s:%d
+//	%+v   function name and path of the file on the next line
+func (f Frame) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		_, _ = io.WriteString(s, path.Base(f.File))
+	case 'd':
+		_, _ = io.WriteString(s, strconv.Itoa(f.Line))
+	case 'n':
+		_, _ = io.WriteString(s, f.Function)
+	case 'v':
+		if s.Flag('+') {
+			_, _ = io.WriteString(s, f.Function+"\n\t"+f.File+":"+strconv.Itoa(f.Line))
+			return
+		}
+		_, _ = io.WriteString(s, path.Base(f.File)+":"+strconv.Itoa(f.Line))
+	}
+}
+
+// Stack holds raw program counters from the innermost call, they're only symbolized by Frames.
+type Stack []uintptr
+
+// NewStack captures the stack of the caller, skip is the number of extra frames to skip.
+func NewStack(skip int) Stack {
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(skip+2, pcs)
+	return pcs[:n:n]
+}
+
+func (s Stack) Frames() []Frame {
+	if len(s) == 0 {
+		return nil
+	}
+	var (
+		frames        = make([]Frame, 0, len(s))
+		runtimeFrames = runtime.CallersFrames(s)
+	)
+	for {
+		runtimeFrame, more := runtimeFrames.Next()
+		if runtimeFrame.Function != "runtime.goexit" {
+			frames = append(frames, Frame{
+				PC:       runtimeFrame.PC,
+				Function: runtimeFrame.Function,
+				File:     runtimeFrame.File,
+				Line:     runtimeFrame.Line,
+			})
+		}
+		if !more {
+			return frames
+		}
+	}
+}
+
+// Format prints one frame per line for `%+v`, otherwise the list of `%v` frames.
+func (s Stack) Format(state fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if state.Flag('+') {
+			for _, frame := range s.Frames() {
+				_, _ = fmt.Fprintf(state, "\n%+v", frame)
+			}
+			return
+		}
+		_, _ = fmt.Fprintf(state, "%v", s.Frames())
+	case 's':
+		_, _ = fmt.Fprintf(state, "%s", s.Frames())
+	}
+}
@@ -0,0 +1,48 @@
+package erroy_test
+
+import (
+	"testing"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+	"github.com/kiyuu10/common-lib-go/erroy/errsentry"
+)
+
+// BenchmarkNewStack is the cost paid by every WrapStack, only program counters are captured.
+func BenchmarkNewStack(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = erroy.NewStack(0)
+	}
+}
+
+// BenchmarkSentryStacktrace is the previous cost of WrapStack, frames are symbolized on capture.
+func BenchmarkSentryStacktrace(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = sentry.NewStacktrace()
+	}
+}
+
+// BenchmarkNewStackToSentry is the cost of an error which is actually reported.
+func BenchmarkNewStackToSentry(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = errsentry.NewStacktrace(erroy.NewStack(0))
+	}
+}
+
+func TestNewStack(t *testing.T) {
+	frames := erroy.NewStack(0).Frames()
+	if len(frames) == 0 {
+		t.Fatal("no frame is captured")
+	}
+	if want := "github.com/kiyuu10/common-lib-go/erroy_test.TestNewStack"; frames[0].Function != want {
+		t.Fatalf("first frame is %s, want %s", frames[0].Function, want)
+	}
+	stacktrace := errsentry.NewStacktrace(erroy.NewStack(0))
+	if last := stacktrace.Frames[len(stacktrace.Frames)-1]; last.Function != "TestNewStack" {
+		t.Fatalf("last sentry frame is %s, want TestNewStack", last.Function)
+	}
+}
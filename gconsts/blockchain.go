@@ -1,19 +1,20 @@
 package gconsts
 
 import (
-	"gitea.alchemymagic.app/snap/go-common/types"
-	"gitlab.com/snap-clickstaff/go-app/lib/gmeta"
+	"github.com/kiyuu10/common-lib-go/gmeta"
+	"github.com/kiyuu10/common-lib-go/types"
 )
 
 const (
-	BlockchainTypeUtxoBitcoin  gmeta.BlockchainType = "utxo-bitcoin"
-	BlockchainTypeUtxoLitecoin gmeta.BlockchainType = "utxo-ltc"
-	BlockchainTypeUtxoDogecoin gmeta.BlockchainType = "utxo-doge"
-	BlockchainTypeEVM          gmeta.BlockchainType = "evm"
-	BlockchainTypeSolana       gmeta.BlockchainType = "solana"
-	BlockchainTypeTron         gmeta.BlockchainType = "tron"
-	BlockchainTypeRipple       gmeta.BlockchainType = "ripple"
-	BlockchainTypeTON          gmeta.BlockchainType = "ton"
+	BlockchainTypeUtxoBitcoin     gmeta.BlockchainType = "utxo-bitcoin"
+	BlockchainTypeUtxoBitcoinCash gmeta.BlockchainType = "utxo-bch"
+	BlockchainTypeUtxoLitecoin    gmeta.BlockchainType = "utxo-ltc"
+	BlockchainTypeUtxoDogecoin    gmeta.BlockchainType = "utxo-doge"
+	BlockchainTypeEVM             gmeta.BlockchainType = "evm"
+	BlockchainTypeSolana          gmeta.BlockchainType = "solana"
+	BlockchainTypeTron            gmeta.BlockchainType = "tron"
+	BlockchainTypeRipple          gmeta.BlockchainType = "ripple"
+	BlockchainTypeTON             gmeta.BlockchainType = "ton"
 )
 
 const (
@@ -91,4 +92,489 @@ var (
 		BlockchainNetworkBitcoinCash,
 		BlockchainNetworkLitecoin,
 	)
+	// BlockchainNetworkMetas is the seed of DefaultRegistry, the `network` validator tag accepts registered networks.
+	BlockchainNetworkMetas = []gmeta.NetworkMeta{
+		{
+			Code:               BlockchainNetworkBitcoin,
+			Type:               BlockchainTypeUtxoBitcoin,
+			NativeCurrency:     CurrencyBitcoin,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://mempool.space/tx/{hash}",
+			ExplorerAddressURL: "https://mempool.space/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkBitcoinTestnet,
+			Type:               BlockchainTypeUtxoBitcoin,
+			NativeCurrency:     CurrencyBitcoin,
+			Mainnet:            BlockchainNetworkBitcoin,
+			Confirmations:      1,
+			ExplorerTxURL:      "https://mempool.space/testnet/tx/{hash}",
+			ExplorerAddressURL: "https://mempool.space/testnet/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkBitcoinCash,
+			Type:               BlockchainTypeUtxoBitcoinCash,
+			NativeCurrency:     CurrencyBitcoinCash,
+			Confirmations:      6,
+			ExplorerTxURL:      "https://blockchair.com/bitcoin-cash/transaction/{hash}",
+			ExplorerAddressURL: "https://blockchair.com/bitcoin-cash/address/{address}",
+		},
+		{
+			Code:           BlockchainNetworkBitcoinCashTestnet,
+			Type:           BlockchainTypeUtxoBitcoinCash,
+			NativeCurrency: CurrencyBitcoinCash,
+			Mainnet:        BlockchainNetworkBitcoinCash,
+			Confirmations:  1,
+		},
+		{
+			Code:               BlockchainNetworkEthereum,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyEthereum,
+			ChainID:            1,
+			Confirmations:      12,
+			ExplorerTxURL:      "https://etherscan.io/tx/{hash}",
+			ExplorerAddressURL: "https://etherscan.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkEthereumTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyEthereum,
+			ChainID:            11155111,
+			Mainnet:            BlockchainNetworkEthereum,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://sepolia.etherscan.io/tx/{hash}",
+			ExplorerAddressURL: "https://sepolia.etherscan.io/address/{address}",
+		},
+		{
+			Code:           BlockchainNetworkEthereumTestRopsten,
+			Type:           BlockchainTypeEVM,
+			NativeCurrency: CurrencyEthereum,
+			ChainID:        3,
+			Mainnet:        BlockchainNetworkEthereum,
+			Confirmations:  3,
+		},
+		{
+			Code:           BlockchainNetworkEthereumTestRinkeby,
+			Type:           BlockchainTypeEVM,
+			NativeCurrency: CurrencyEthereum,
+			ChainID:        4,
+			Mainnet:        BlockchainNetworkEthereum,
+			Confirmations:  3,
+		},
+		{
+			Code:               BlockchainNetworkLitecoin,
+			Type:               BlockchainTypeUtxoLitecoin,
+			NativeCurrency:     CurrencyLitecoin,
+			Confirmations:      6,
+			ExplorerTxURL:      "https://blockchair.com/litecoin/transaction/{hash}",
+			ExplorerAddressURL: "https://blockchair.com/litecoin/address/{address}",
+		},
+		{
+			Code:           BlockchainNetworkLitecoinTestnet,
+			Type:           BlockchainTypeUtxoLitecoin,
+			NativeCurrency: CurrencyLitecoin,
+			Mainnet:        BlockchainNetworkLitecoin,
+			Confirmations:  1,
+		},
+		{
+			Code:               BlockchainNetworkTron,
+			Type:               BlockchainTypeTron,
+			NativeCurrency:     CurrencyTron,
+			Confirmations:      19,
+			ExplorerTxURL:      "https://tronscan.org/#/transaction/{hash}",
+			ExplorerAddressURL: "https://tronscan.org/#/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkTronTestNile,
+			Type:               BlockchainTypeTron,
+			NativeCurrency:     CurrencyTron,
+			Mainnet:            BlockchainNetworkTron,
+			Confirmations:      1,
+			ExplorerTxURL:      "https://nile.tronscan.org/#/transaction/{hash}",
+			ExplorerAddressURL: "https://nile.tronscan.org/#/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkRipple,
+			Type:               BlockchainTypeRipple,
+			NativeCurrency:     CurrencyRipple,
+			Confirmations:      1,
+			ExplorerTxURL:      "https://xrpscan.com/tx/{hash}",
+			ExplorerAddressURL: "https://xrpscan.com/account/{address}",
+		},
+		{
+			Code:               BlockchainNetworkRippleTestnet,
+			Type:               BlockchainTypeRipple,
+			NativeCurrency:     CurrencyRipple,
+			Mainnet:            BlockchainNetworkRipple,
+			Confirmations:      1,
+			ExplorerTxURL:      "https://testnet.xrpl.org/transactions/{hash}",
+			ExplorerAddressURL: "https://testnet.xrpl.org/accounts/{address}",
+		},
+		{
+			Code:               BlockchainNetworkAurora,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyEthereum,
+			ChainID:            1313161554,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://explorer.aurora.dev/tx/{hash}",
+			ExplorerAddressURL: "https://explorer.aurora.dev/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkAuroraTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyEthereum,
+			ChainID:            1313161555,
+			Mainnet:            BlockchainNetworkAurora,
+			Confirmations:      1,
+			ExplorerTxURL:      "https://explorer.testnet.aurora.dev/tx/{hash}",
+			ExplorerAddressURL: "https://explorer.testnet.aurora.dev/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkAvalanche,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyAvalanche,
+			ChainID:            43114,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://snowtrace.io/tx/{hash}",
+			ExplorerAddressURL: "https://snowtrace.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkAvalancheTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyAvalanche,
+			ChainID:            43113,
+			Mainnet:            BlockchainNetworkAvalanche,
+			Confirmations:      1,
+			ExplorerTxURL:      "https://testnet.snowtrace.io/tx/{hash}",
+			ExplorerAddressURL: "https://testnet.snowtrace.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkBinanceSmartChain,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyBinanceCoin,
+			ChainID:            56,
+			Confirmations:      15,
+			ExplorerTxURL:      "https://bscscan.com/tx/{hash}",
+			ExplorerAddressURL: "https://bscscan.com/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkBinanceSmartChainTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyBinanceCoin,
+			ChainID:            97,
+			Mainnet:            BlockchainNetworkBinanceSmartChain,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://testnet.bscscan.com/tx/{hash}",
+			ExplorerAddressURL: "https://testnet.bscscan.com/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkCelo,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyCelo,
+			ChainID:            42220,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://celoscan.io/tx/{hash}",
+			ExplorerAddressURL: "https://celoscan.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkCeloTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyCelo,
+			ChainID:            44787,
+			Mainnet:            BlockchainNetworkCelo,
+			Confirmations:      1,
+			ExplorerTxURL:      "https://alfajores.celoscan.io/tx/{hash}",
+			ExplorerAddressURL: "https://alfajores.celoscan.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkCronos,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyCronos,
+			ChainID:            25,
+			Confirmations:      12,
+			ExplorerTxURL:      "https://cronoscan.com/tx/{hash}",
+			ExplorerAddressURL: "https://cronoscan.com/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkCronosTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyCronos,
+			ChainID:            338,
+			Mainnet:            BlockchainNetworkCronos,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://explorer.cronos.org/testnet/tx/{hash}",
+			ExplorerAddressURL: "https://explorer.cronos.org/testnet/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkFantom,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyFantom,
+			ChainID:            250,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://ftmscan.com/tx/{hash}",
+			ExplorerAddressURL: "https://ftmscan.com/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkFantomTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyFantom,
+			ChainID:            4002,
+			Mainnet:            BlockchainNetworkFantom,
+			Confirmations:      1,
+			ExplorerTxURL:      "https://testnet.ftmscan.com/tx/{hash}",
+			ExplorerAddressURL: "https://testnet.ftmscan.com/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkGnosis,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyXDAI,
+			ChainID:            100,
+			Confirmations:      12,
+			ExplorerTxURL:      "https://gnosisscan.io/tx/{hash}",
+			ExplorerAddressURL: "https://gnosisscan.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkGnosisTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyXDAI,
+			ChainID:            10200,
+			Mainnet:            BlockchainNetworkGnosis,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://gnosis-chiado.blockscout.com/tx/{hash}",
+			ExplorerAddressURL: "https://gnosis-chiado.blockscout.com/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkHarmony,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyHarmony,
+			ChainID:            1666600000,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://explorer.harmony.one/tx/{hash}",
+			ExplorerAddressURL: "https://explorer.harmony.one/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkHarmonyTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyHarmony,
+			ChainID:            1666700000,
+			Mainnet:            BlockchainNetworkHarmony,
+			Confirmations:      1,
+			ExplorerTxURL:      "https://explorer.testnet.harmony.one/tx/{hash}",
+			ExplorerAddressURL: "https://explorer.testnet.harmony.one/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkHecoChain,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyHECO,
+			ChainID:            128,
+			Confirmations:      20,
+			ExplorerTxURL:      "https://hecoinfo.com/tx/{hash}",
+			ExplorerAddressURL: "https://hecoinfo.com/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkHecoChainTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyHECO,
+			ChainID:            256,
+			Mainnet:            BlockchainNetworkHecoChain,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://testnet.hecoinfo.com/tx/{hash}",
+			ExplorerAddressURL: "https://testnet.hecoinfo.com/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkMoonriver,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyMoonriver,
+			ChainID:            1285,
+			Confirmations:      12,
+			ExplorerTxURL:      "https://moonriver.moonscan.io/tx/{hash}",
+			ExplorerAddressURL: "https://moonriver.moonscan.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkMoonriverTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyMoonriver,
+			ChainID:            1287,
+			Mainnet:            BlockchainNetworkMoonriver,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://moonbase.moonscan.io/tx/{hash}",
+			ExplorerAddressURL: "https://moonbase.moonscan.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkOKExChain,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyOKEx,
+			ChainID:            66,
+			Confirmations:      12,
+			ExplorerTxURL:      "https://www.oklink.com/oktc/tx/{hash}",
+			ExplorerAddressURL: "https://www.oklink.com/oktc/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkOKExChainTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyOKEx,
+			ChainID:            65,
+			Mainnet:            BlockchainNetworkOKExChain,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://www.oklink.com/oktc-test/tx/{hash}",
+			ExplorerAddressURL: "https://www.oklink.com/oktc-test/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkPolygon,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyPolygonMatic,
+			ChainID:            137,
+			Confirmations:      128,
+			ExplorerTxURL:      "https://polygonscan.com/tx/{hash}",
+			ExplorerAddressURL: "https://polygonscan.com/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkPolygonTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyPolygonMatic,
+			ChainID:            80002,
+			Mainnet:            BlockchainNetworkPolygon,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://amoy.polygonscan.com/tx/{hash}",
+			ExplorerAddressURL: "https://amoy.polygonscan.com/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkVelas,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyVelas,
+			ChainID:            106,
+			Confirmations:      12,
+			ExplorerTxURL:      "https://explorer.velas.com/tx/{hash}",
+			ExplorerAddressURL: "https://explorer.velas.com/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkVelasTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyVelas,
+			ChainID:            111,
+			Mainnet:            BlockchainNetworkVelas,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://explorer.testnet.velas.com/tx/{hash}",
+			ExplorerAddressURL: "https://explorer.testnet.velas.com/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkFuse,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyFuse,
+			ChainID:            122,
+			Confirmations:      12,
+			ExplorerTxURL:      "https://explorer.fuse.io/tx/{hash}",
+			ExplorerAddressURL: "https://explorer.fuse.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkFuseTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyFuse,
+			ChainID:            123,
+			Mainnet:            BlockchainNetworkFuse,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://explorer.fusespark.io/tx/{hash}",
+			ExplorerAddressURL: "https://explorer.fusespark.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkSolana,
+			Type:               BlockchainTypeSolana,
+			NativeCurrency:     CurrencySolana,
+			Confirmations:      32,
+			ExplorerTxURL:      "https://solscan.io/tx/{hash}",
+			ExplorerAddressURL: "https://solscan.io/account/{address}",
+		},
+		{
+			Code:               BlockchainNetworkSolanaTestnet,
+			Type:               BlockchainTypeSolana,
+			NativeCurrency:     CurrencySolana,
+			Mainnet:            BlockchainNetworkSolana,
+			Confirmations:      1,
+			ExplorerTxURL:      "https://solscan.io/tx/{hash}?cluster=testnet",
+			ExplorerAddressURL: "https://solscan.io/account/{address}?cluster=testnet",
+		},
+		{
+			Code:               BlockchainNetworkOptimism,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyEthereum,
+			ChainID:            10,
+			Confirmations:      12,
+			ExplorerTxURL:      "https://optimistic.etherscan.io/tx/{hash}",
+			ExplorerAddressURL: "https://optimistic.etherscan.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkOptimismTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyEthereum,
+			ChainID:            11155420,
+			Mainnet:            BlockchainNetworkOptimism,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://sepolia-optimism.etherscan.io/tx/{hash}",
+			ExplorerAddressURL: "https://sepolia-optimism.etherscan.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkArbitrumOne,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyEthereum,
+			ChainID:            42161,
+			Confirmations:      12,
+			ExplorerTxURL:      "https://arbiscan.io/tx/{hash}",
+			ExplorerAddressURL: "https://arbiscan.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkArbitrumTestnet,
+			Type:               BlockchainTypeEVM,
+			NativeCurrency:     CurrencyEthereum,
+			ChainID:            421614,
+			Mainnet:            BlockchainNetworkArbitrumOne,
+			Confirmations:      3,
+			ExplorerTxURL:      "https://sepolia.arbiscan.io/tx/{hash}",
+			ExplorerAddressURL: "https://sepolia.arbiscan.io/address/{address}",
+		},
+		{
+			Code:               BlockchainNetworkTon,
+			Type:               BlockchainTypeTON,
+			NativeCurrency:     CurrencyTON,
+			Confirmations:      1,
+			ExplorerTxURL:      "https://tonviewer.com/transaction/{hash}",
+			ExplorerAddressURL: "https://tonviewer.com/{address}",
+		},
+		{
+			Code:               BlockchainNetworkTonTestnet,
+			Type:               BlockchainTypeTON,
+			NativeCurrency:     CurrencyTON,
+			Mainnet:            BlockchainNetworkTon,
+			Confirmations:      1,
+			ExplorerTxURL:      "https://testnet.tonviewer.com/transaction/{hash}",
+			ExplorerAddressURL: "https://testnet.tonviewer.com/{address}",
+		},
+		{
+			Code:               BlockchainNetworkDogecoin,
+			Type:               BlockchainTypeUtxoDogecoin,
+			NativeCurrency:     CurrencyDogecoin,
+			Confirmations:      6,
+			ExplorerTxURL:      "https://blockchair.com/dogecoin/transaction/{hash}",
+			ExplorerAddressURL: "https://blockchair.com/dogecoin/address/{address}",
+		},
+		{
+			Code:           BlockchainNetworkDogecoinTestnet,
+			Type:           BlockchainTypeUtxoDogecoin,
+			NativeCurrency: CurrencyDogecoin,
+			Mainnet:        BlockchainNetworkDogecoin,
+			Confirmations:  1,
+		},
+		{
+			Code: BlockchainNetworkHunnyPlayNetwork,
+		},
+		{
+			Code: BlockchainNetworkFiat,
+		},
+	}
 )
+
+// RegisterKnownBlockchainNetwork registers networks without meta, use DefaultRegistry.RegisterNetwork for full metas.
+func RegisterKnownBlockchainNetwork(networks ...gmeta.BlockchainNetwork) {
+	for _, network := range networks {
+		DefaultRegistry.RegisterNetwork(gmeta.NetworkMeta{Code: network})
+	}
+}
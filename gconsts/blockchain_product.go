@@ -1,6 +1,6 @@
 package gconsts
 
-import "gitlab.com/snap-clickstaff/go-app/lib/gmeta"
+import "github.com/kiyuu10/common-lib-go/gmeta"
 
 const (
 	BlockchainProductStatusInit          gmeta.BlockchainProductStatus = 1
@@ -3,13 +3,15 @@ package gconsts
 import (
 	"fmt"
 
-	"gitea.alchemymagic.app/snap/go-common/types"
-	comutils "gitea.alchemymagic.app/snap/go-common/utils"
-	"gitlab.com/snap-clickstaff/go-app/lib/gmeta"
+	"github.com/shopspring/decimal"
+
+	"github.com/kiyuu10/common-lib-go/gmeta"
+	"github.com/kiyuu10/common-lib-go/types"
+	comutils "github.com/kiyuu10/common-lib-go/utils"
 )
 
 const (
-	CoinCodeSep                = "@"
+	CoinCodeSep                = gmeta.CoinIndexCodeSep
 	CurrencyUSD gmeta.Currency = "USD"
 	CurrencyCNY gmeta.Currency = "CNY"
 	CurrencyMYR gmeta.Currency = "MYR"
@@ -60,6 +62,7 @@ const (
 )
 
 var (
+	// CurrencyIdenticalMap is the seed of DefaultRegistry, use RegisterIdenticalCurrency at runtime.
 	CurrencyIdenticalMap    = make(map[gmeta.Currency]gmeta.Currency)
 	CurrencyConversionRates = []gmeta.CurrencyConversionRate{
 		{
@@ -148,53 +151,313 @@ var (
 	})
 )
 
-func RegisterIdenticalCurrency(currency gmeta.Currency, identicalTo gmeta.Currency) {
-	if currency != identicalTo {
-		CurrencyIdenticalMap[currency] = identicalTo
-	}
-}
-
+// CurrencyMetaMap is the seed of DefaultRegistry, use GetCurrencyMeta to read metas.
+// Tokens having different decimals per network use the largest ones.
 var CurrencyMetaMap = map[gmeta.Currency]gmeta.CurrencyMeta{
 	CurrencyUSD: {
 		Code:          CurrencyUSD,
 		DecimalPlaces: 2,
+		Symbol:        "$",
+		Kind:          gmeta.CurrencyKindFiat,
 	},
 	CurrencyCNY: {
 		Code:          CurrencyCNY,
 		DecimalPlaces: 2,
+		Symbol:        "¥",
+		Kind:          gmeta.CurrencyKindFiat,
 	},
-	CurrencyHunny: {
-		Code:          CurrencyHunny,
-		DecimalPlaces: 18,
+	CurrencyMYR: {
+		Code:          CurrencyMYR,
+		DecimalPlaces: 2,
+		Symbol:        "RM",
+		Kind:          gmeta.CurrencyKindFiat,
+	},
+	CurrencyTHB: {
+		Code:          CurrencyTHB,
+		DecimalPlaces: 2,
+		Symbol:        "฿",
+		Kind:          gmeta.CurrencyKindFiat,
+	},
+	CurrencyIDR: {
+		Code:          CurrencyIDR,
+		DecimalPlaces: 2,
+		Symbol:        "Rp",
+		Kind:          gmeta.CurrencyKindFiat,
 	},
-
 	CurrencyBitcoin: {
 		Code:          CurrencyBitcoin,
 		DecimalPlaces: 8,
+		Symbol:        "BTC",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyBitcoinCash: {
+		Code:          CurrencyBitcoinCash,
+		DecimalPlaces: 8,
+		Symbol:        "BCH",
+		Kind:          gmeta.CurrencyKindCrypto,
 	},
 	CurrencyEthereum: {
 		Code:          CurrencyEthereum,
 		DecimalPlaces: 18,
+		Symbol:        "ETH",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyLitecoin: {
+		Code:          CurrencyLitecoin,
+		DecimalPlaces: 8,
+		Symbol:        "LTC",
+		Kind:          gmeta.CurrencyKindCrypto,
 	},
 	CurrencyTron: {
 		Code:          CurrencyTron,
 		DecimalPlaces: 6,
+		Symbol:        "TRX",
+		Kind:          gmeta.CurrencyKindCrypto,
 	},
 	CurrencyRipple: {
 		Code:          CurrencyRipple,
 		DecimalPlaces: 6,
+		Symbol:        "XRP",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyBinanceCoin: {
+		Code:          CurrencyBinanceCoin,
+		DecimalPlaces: 18,
+		Symbol:        "BNB",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyAvalanche: {
+		Code:          CurrencyAvalanche,
+		DecimalPlaces: 18,
+		Symbol:        "AVAX",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyOKEx: {
+		Code:          CurrencyOKEx,
+		DecimalPlaces: 18,
+		Symbol:        "OKT",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyFantom: {
+		Code:          CurrencyFantom,
+		DecimalPlaces: 18,
+		Symbol:        "FTM",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyPolygonMatic: {
+		Code:          CurrencyPolygonMatic,
+		DecimalPlaces: 18,
+		Symbol:        "MATIC",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyCelo: {
+		Code:          CurrencyCelo,
+		DecimalPlaces: 18,
+		Symbol:        "CELO",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyHarmony: {
+		Code:          CurrencyHarmony,
+		DecimalPlaces: 18,
+		Symbol:        "ONE",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyCronos: {
+		Code:          CurrencyCronos,
+		DecimalPlaces: 18,
+		Symbol:        "CRO",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyHECO: {
+		Code:          CurrencyHECO,
+		DecimalPlaces: 18,
+		Symbol:        "HT",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyXDAI: {
+		Code:          CurrencyXDAI,
+		DecimalPlaces: 18,
+		Symbol:        "XDAI",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyMoonriver: {
+		Code:          CurrencyMoonriver,
+		DecimalPlaces: 18,
+		Symbol:        "MOVR",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyVelas: {
+		Code:          CurrencyVelas,
+		DecimalPlaces: 18,
+		Symbol:        "VLX",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyFuse: {
+		Code:          CurrencyFuse,
+		DecimalPlaces: 18,
+		Symbol:        "FUSE",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyTetherUSD: {
+		Code:          CurrencyTetherUSD,
+		DecimalPlaces: 18,
+		Symbol:        "USDT",
+		Kind:          gmeta.CurrencyKindStablecoin,
+	},
+	CurrencyBinanceUSD: {
+		Code:          CurrencyBinanceUSD,
+		DecimalPlaces: 18,
+		Symbol:        "BUSD",
+		Kind:          gmeta.CurrencyKindStablecoin,
+	},
+	CurrencyHunny: {
+		Code:          CurrencyHunny,
+		DecimalPlaces: 18,
+		Symbol:        "HUNNY",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyHUSD: {
+		Code:          CurrencyHUSD,
+		DecimalPlaces: 18,
+		Symbol:        "HUSD",
+		Kind:          gmeta.CurrencyKindStablecoin,
+	},
+	CurrencyCake: {
+		Code:          CurrencyCake,
+		DecimalPlaces: 18,
+		Symbol:        "CAKE",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencySolana: {
+		Code:          CurrencySolana,
+		DecimalPlaces: 9,
+		Symbol:        "SOL",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyUSDCoin: {
+		Code:          CurrencyUSDCoin,
+		DecimalPlaces: 18,
+		Symbol:        "USDC",
+		Kind:          gmeta.CurrencyKindStablecoin,
+	},
+	CurrencyAxelarWrappedUSDC: {
+		Code:          CurrencyAxelarWrappedUSDC,
+		DecimalPlaces: 18,
+		Symbol:        "USDC",
+		Kind:          gmeta.CurrencyKindStablecoin,
+	},
+	CurrencyLove: {
+		Code:          CurrencyLove,
+		DecimalPlaces: 18,
+		Symbol:        "LOVE",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyTON: {
+		Code:          CurrencyTON,
+		DecimalPlaces: 9,
+		Symbol:        "TON",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencyDogecoin: {
+		Code:          CurrencyDogecoin,
+		DecimalPlaces: 8,
+		Symbol:        "DOGE",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencySubBitcoinSatoshi: {
+		Code:          CurrencySubBitcoinSatoshi,
+		DecimalPlaces: 0,
+		Symbol:        "sat",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencySubEvmGwei: {
+		Code:          CurrencySubEvmGwei,
+		DecimalPlaces: 0,
+		Symbol:        "gwei",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencySubEvmWei: {
+		Code:          CurrencySubEvmWei,
+		DecimalPlaces: 0,
+		Symbol:        "wei",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencySubTronSun: {
+		Code:          CurrencySubTronSun,
+		DecimalPlaces: 0,
+		Symbol:        "sun",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencySubTronBandwidth: {
+		Code:          CurrencySubTronBandwidth,
+		DecimalPlaces: 0,
+		Symbol:        "bandwidth",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencySubTronEnergy: {
+		Code:          CurrencySubTronEnergy,
+		DecimalPlaces: 0,
+		Symbol:        "energy",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencySubRippleDrop: {
+		Code:          CurrencySubRippleDrop,
+		DecimalPlaces: 0,
+		Symbol:        "drop",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencySubSolanaLamport: {
+		Code:          CurrencySubSolanaLamport,
+		DecimalPlaces: 0,
+		Symbol:        "lamport",
+		Kind:          gmeta.CurrencyKindCrypto,
+	},
+	CurrencySubTONGrams: {
+		Code:          CurrencySubTONGrams,
+		DecimalPlaces: 0,
+		Symbol:        "grams",
+		Kind:          gmeta.CurrencyKindCrypto,
 	},
 }
 
-func GetCurrencyMeta(currency gmeta.Currency) (_ gmeta.CurrencyMeta, exists bool) {
-	if currencyMeta, ok := CurrencyMetaMap[currency]; ok {
-		return currencyMeta, true
+// RegisterKnownCurrency registers crypto currencies with gmeta.MoneyDefaultDecimalPlaces (18) decimal places,
+// use DefaultRegistry.RegisterCurrency for other precisions.
+func RegisterKnownCurrency(currencies ...gmeta.Currency) {
+	for _, currency := range currencies {
+		DefaultRegistry.RegisterCurrency(gmeta.CurrencyMeta{
+			Code:          currency,
+			DecimalPlaces: gmeta.MoneyDefaultDecimalPlaces,
+			Kind:          gmeta.CurrencyKindCrypto,
+		})
 	}
-	if identicalCurrency, ok := CurrencyIdenticalMap[currency]; ok {
-		if currencyMeta, ok := CurrencyMetaMap[identicalCurrency]; ok {
-			currencyMeta.Code = currency
-			return currencyMeta, true
-		}
-	}
-	return
+}
+
+func IsKnownCurrency(currency gmeta.Currency) bool {
+	return DefaultRegistry.IsKnownCurrency(currency)
+}
+
+func RegisterIdenticalCurrency(currency gmeta.Currency, identicalTo gmeta.Currency) {
+	DefaultRegistry.RegisterAlias(currency, identicalTo)
+}
+
+func GetCurrencyMeta(currency gmeta.Currency) (_ gmeta.CurrencyMeta, exists bool) {
+	return DefaultRegistry.Currency(currency)
+}
+
+// CurrencyConverter panics on startup if CurrencyConversionRates are inconsistent.
+var CurrencyConverter = newCurrencyConverter()
+
+func newCurrencyConverter() *gmeta.CurrencyConverter {
+	converter := gmeta.NewCurrencyConverterF(CurrencyConversionRates...)
+	converter.ErrorThreshold = AmountNormalizeErrorThreshold
+	return converter
+}
+
+// RegisterCurrencyConversionRate lets applications add units of their tokens at runtime.
+func RegisterCurrencyConversionRate(rates ...gmeta.CurrencyConversionRate) error {
+	return CurrencyConverter.AddRates(rates...)
+}
+
+func ConvertCurrency(amount decimal.Decimal, from gmeta.Currency, to gmeta.Currency) (decimal.Decimal, error) {
+	return CurrencyConverter.Convert(amount, from, to)
 }
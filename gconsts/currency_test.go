@@ -0,0 +1,35 @@
+package gconsts
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kiyuu10/common-lib-go/gmeta"
+)
+
+func TestCurrencyConverterSeed(t *testing.T) {
+	tests := []struct {
+		from     gmeta.Currency
+		to       gmeta.Currency
+		exponent int32
+		err      error
+	}{
+		{CurrencyBitcoin, CurrencySubBitcoinSatoshi, 8, nil},
+		{CurrencyLitecoin, CurrencySubBitcoinSatoshi, 8, nil},
+		{CurrencyBitcoin, CurrencyLitecoin, 0, gmeta.ErrCurrencyConversionNotFound},
+		{CurrencyEthereum, CurrencySubEvmWei, 18, nil},
+		{CurrencySubTronEnergy, CurrencyTron, -5, nil},
+	}
+	for _, tt := range tests {
+		exponent, err := CurrencyConverter.Exponent(tt.from, tt.to)
+		if !errors.Is(err, tt.err) || exponent != tt.exponent {
+			t.Fatalf("Exponent(%s, %s) = %d, %v, want %d, %v", tt.from, tt.to, exponent, err, tt.exponent, tt.err)
+		}
+	}
+
+	for _, key := range []string{"LTC-satoshi", "satoshi-LTC"} {
+		if _, ok := CurrencyConversionRateMap[key]; !ok {
+			t.Fatalf("CurrencyConversionRateMap misses %s", key)
+		}
+	}
+}
@@ -1,6 +1,15 @@
 package gconsts
 
-import "gitlab.com/snap-clickstaff/go-app/lib/gmeta"
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/kiyuu10/common-lib-go/gmeta"
+	"github.com/kiyuu10/common-lib-go/types"
+)
 
 const (
 	ErrorCodeSuccess         gmeta.ErrorCode = "success"
@@ -80,7 +89,9 @@ var (
 	ErrorDataClosed      = gmeta.NewOurError(ErrorCodeDataClosed)
 	ErrorDataLocked      = gmeta.NewOurError(ErrorCodeDataLocked)
 	ErrorDataDuplicate   = gmeta.NewOurError(ErrorCodeDataDuplicate)
-	ErrorDataMaintenance = gmeta.NewOurError(ErrorCodeMaintenance)
+	ErrorMaintenance     = gmeta.NewOurError(ErrorCodeMaintenance)
+	// Deprecated: use ErrorMaintenance.
+	ErrorDataMaintenance = ErrorMaintenance
 
 	ErrorAddress                          = gmeta.NewOurError(ErrorCodeAddress)
 	ErrorAmount                           = gmeta.NewOurError(ErrorCodeAmount)
@@ -122,3 +133,408 @@ var (
 	ErrorUserLinkedAnotherAccount         = gmeta.NewOurError(ErrorCodeUserLinkedAnotherAccount)
 	ErrorGameRestrictedByVoucher          = gmeta.NewOurError(ErrorCodeGameRestrictedByVoucher)
 )
+
+// ErrorCodeMetas is the catalog of built-in codes, it's registered on initialization.
+var ErrorCodeMetas = []gmeta.ErrorCodeMeta{
+	{
+		Code:        ErrorCodeSuccess,
+		HttpStatus:  http.StatusOK,
+		GrpcCode:    codes.OK,
+		Description: "The request succeeded.",
+	},
+	{
+		Code:        ErrorCodeUnknown,
+		HttpStatus:  http.StatusInternalServerError,
+		GrpcCode:    codes.Unknown,
+		Description: "An unclassified error, usually a bug.",
+	},
+	{
+		Code:        ErrorCodeSystem,
+		HttpStatus:  http.StatusInternalServerError,
+		GrpcCode:    codes.Internal,
+		Description: "An internal failure of the service.",
+	},
+	{
+		Code:        ErrorCodeAuth,
+		HttpStatus:  http.StatusUnauthorized,
+		GrpcCode:    codes.Unauthenticated,
+		Description: "The request is not authenticated.",
+	},
+	{
+		Code:        ErrorCodeAccess,
+		HttpStatus:  http.StatusForbidden,
+		GrpcCode:    codes.PermissionDenied,
+		Description: "The user has no permission on the resource.",
+	},
+	{
+		Code:        ErrorCodeAuthBlocked,
+		HttpStatus:  http.StatusForbidden,
+		GrpcCode:    codes.PermissionDenied,
+		Description: "The account is blocked from authenticating.",
+	},
+	{
+		Code:        ErrorCodeIgnored,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.Aborted,
+		Description: "The request was ignored and had no effect.",
+	},
+	{
+		Code:        ErrorCodeInvalidParams,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.InvalidArgument,
+		Description: "The request parameters are invalid.",
+	},
+	{
+		Code:        ErrorCodeInvalidData,
+		HttpStatus:  http.StatusUnprocessableEntity,
+		GrpcCode:    codes.InvalidArgument,
+		Description: "The request data is well-formed but semantically invalid.",
+	},
+	{
+		Code:        ErrorCodeTimeout,
+		HttpStatus:  http.StatusGatewayTimeout,
+		GrpcCode:    codes.DeadlineExceeded,
+		Retryable:   true,
+		Description: "The operation timed out.",
+	},
+	{
+		Code:        ErrorCodeTooManyRequests,
+		HttpStatus:  http.StatusTooManyRequests,
+		GrpcCode:    codes.ResourceExhausted,
+		Retryable:   true,
+		Description: "The rate limit was exceeded.",
+	},
+	{
+		Code:        ErrorCodeDataNotFound,
+		HttpStatus:  http.StatusNotFound,
+		GrpcCode:    codes.NotFound,
+		Description: "The requested data does not exist.",
+	},
+	{
+		Code:        ErrorCodeDataExists,
+		HttpStatus:  http.StatusConflict,
+		GrpcCode:    codes.AlreadyExists,
+		Description: "The data already exists.",
+	},
+	{
+		Code:        ErrorCodeDataExpired,
+		HttpStatus:  http.StatusGone,
+		GrpcCode:    codes.FailedPrecondition,
+		Description: "The data has expired.",
+	},
+	{
+		Code:        ErrorCodeDataClosed,
+		HttpStatus:  http.StatusConflict,
+		GrpcCode:    codes.FailedPrecondition,
+		Description: "The data has been closed.",
+	},
+	{
+		Code:        ErrorCodeDataLocked,
+		HttpStatus:  http.StatusLocked,
+		GrpcCode:    codes.Aborted,
+		Retryable:   true,
+		Description: "The data is locked by another operation.",
+	},
+	{
+		Code:        ErrorCodeDataDuplicate,
+		HttpStatus:  http.StatusConflict,
+		GrpcCode:    codes.AlreadyExists,
+		Description: "The data is duplicated.",
+	},
+	{
+		Code:        ErrorCodeDependency,
+		HttpStatus:  http.StatusBadGateway,
+		GrpcCode:    codes.Unavailable,
+		Retryable:   true,
+		Description: "A dependent service failed.",
+	},
+	{
+		Code:        ErrorCodeMaintenance,
+		HttpStatus:  http.StatusServiceUnavailable,
+		GrpcCode:    codes.Unavailable,
+		Retryable:   true,
+		Description: "The service is under maintenance.",
+	},
+
+	{
+		Code:        ErrorCodeAddress,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.InvalidArgument,
+		Description: "The blockchain address is invalid.",
+	},
+	{
+		Code:        ErrorCodeAmount,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.InvalidArgument,
+		Description: "The amount is invalid.",
+	},
+	{
+		Code:        ErrorCodeAmountTooHigh,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.OutOfRange,
+		Description: "The amount is higher than the maximum.",
+	},
+	{
+		Code:        ErrorCodeAmountTooHighWithValue,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.OutOfRange,
+		Description: "The amount is higher than the given maximum value.",
+	},
+	{
+		Code:        ErrorCodeAmountTooLow,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.OutOfRange,
+		Description: "The amount is lower than the minimum.",
+	},
+	{
+		Code:        ErrorCodeAmountTooLowWithValue,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.OutOfRange,
+		Description: "The amount is lower than the given minimum value.",
+	},
+	{
+		Code:        ErrorCodeAuthTOTP,
+		HttpStatus:  http.StatusUnauthorized,
+		GrpcCode:    codes.Unauthenticated,
+		Description: "The TOTP code is invalid.",
+	},
+	{
+		Code:        ErrorCodeAuthInput,
+		HttpStatus:  http.StatusUnauthorized,
+		GrpcCode:    codes.Unauthenticated,
+		Description: "The authentication input is invalid.",
+	},
+	{
+		Code:        ErrorCodeAuthPassword,
+		HttpStatus:  http.StatusUnauthorized,
+		GrpcCode:    codes.Unauthenticated,
+		Description: "The password is incorrect.",
+	},
+	{
+		Code:        ErrorCodeAuthTelegram,
+		HttpStatus:  http.StatusUnauthorized,
+		GrpcCode:    codes.Unauthenticated,
+		Description: "The Telegram authentication failed.",
+	},
+	{
+		Code:        ErrorCodeBalanceNotEnough,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.FailedPrecondition,
+		Description: "The balance is not enough.",
+	},
+	{
+		Code:        ErrorCodeBlockchainBalanceNotEnoughForFee,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.FailedPrecondition,
+		Description: "The blockchain balance is not enough to pay the fee.",
+	},
+	{
+		Code:        ErrorCodeBlockchainNetwork,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.InvalidArgument,
+		Description: "The blockchain network is invalid or not supported.",
+	},
+	{
+		Code:        ErrorCodeChannelNotAvailable,
+		HttpStatus:  http.StatusServiceUnavailable,
+		GrpcCode:    codes.Unavailable,
+		Retryable:   true,
+		Description: "The channel is not available.",
+	},
+	{
+		Code:        ErrorCodeCountryBanned,
+		HttpStatus:  http.StatusForbidden,
+		GrpcCode:    codes.PermissionDenied,
+		Description: "The country is banned.",
+	},
+	{
+		Code:        ErrorCodeCurrency,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.InvalidArgument,
+		Description: "The currency is invalid or not supported.",
+	},
+	{
+		Code:        ErrorCodeDobInvalid,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.InvalidArgument,
+		Description: "The date of birth is invalid.",
+	},
+	{
+		Code:        ErrorCodeEmailInvalid,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.InvalidArgument,
+		Description: "The email is invalid.",
+	},
+	{
+		Code:        ErrorCodeEmailNotSent,
+		HttpStatus:  http.StatusBadGateway,
+		GrpcCode:    codes.Unavailable,
+		Retryable:   true,
+		Description: "The email could not be sent.",
+	},
+	{
+		Code:        ErrorCodeFeatureNotSupport,
+		HttpStatus:  http.StatusNotImplemented,
+		GrpcCode:    codes.Unimplemented,
+		Description: "The feature is not supported.",
+	},
+	{
+		Code:        ErrorCodeKycRequestInvalidStatus,
+		HttpStatus:  http.StatusConflict,
+		GrpcCode:    codes.FailedPrecondition,
+		Description: "The KYC request status does not allow the action.",
+	},
+	{
+		Code:        ErrorCodeKycRequired,
+		HttpStatus:  http.StatusForbidden,
+		GrpcCode:    codes.PermissionDenied,
+		Description: "KYC verification is required.",
+	},
+	{
+		Code:        ErrorCodeKycUserBlacklist,
+		HttpStatus:  http.StatusForbidden,
+		GrpcCode:    codes.PermissionDenied,
+		Description: "The user is on the KYC blacklist.",
+	},
+	{
+		Code:        ErrorCodeOrderConcurrent,
+		HttpStatus:  http.StatusConflict,
+		GrpcCode:    codes.Aborted,
+		Retryable:   true,
+		Description: "Another order is being processed concurrently.",
+	},
+	{
+		Code:        ErrorCodeOrderDuplicated,
+		HttpStatus:  http.StatusConflict,
+		GrpcCode:    codes.AlreadyExists,
+		Description: "The order is duplicated.",
+	},
+	{
+		Code:        ErrorCodeOrderInvalid,
+		HttpStatus:  http.StatusBadRequest,
+		GrpcCode:    codes.InvalidArgument,
+		Description: "The order is invalid.",
+	},
+	{
+		Code:        ErrorCodeOrderNotFound,
+		HttpStatus:  http.StatusNotFound,
+		GrpcCode:    codes.NotFound,
+		Description: "The order does not exist.",
+	},
+	{
+		Code:        ErrorCodeOrderStatus,
+		HttpStatus:  http.StatusConflict,
+		GrpcCode:    codes.FailedPrecondition,
+		Description: "The order status does not allow the action.",
+	},
+	{
+		Code:        ErrorCodeStatus,
+		HttpStatus:  http.StatusConflict,
+		GrpcCode:    codes.FailedPrecondition,
+		Description: "The status does not allow the action.",
+	},
+	{
+		Code:        ErrorCodeUserActionLocked,
+		HttpStatus:  http.StatusLocked,
+		GrpcCode:    codes.PermissionDenied,
+		Description: "The user action is temporarily locked.",
+	},
+	{
+		Code:        ErrorCodeUserLogin,
+		HttpStatus:  http.StatusUnauthorized,
+		GrpcCode:    codes.Unauthenticated,
+		Description: "The login failed.",
+	},
+	{
+		Code:        ErrorCodeUserNotFound,
+		HttpStatus:  http.StatusNotFound,
+		GrpcCode:    codes.NotFound,
+		Description: "The user does not exist.",
+	},
+	{
+		Code:        ErrorCodeUserReferralNotFound,
+		HttpStatus:  http.StatusNotFound,
+		GrpcCode:    codes.NotFound,
+		Description: "The referral user does not exist.",
+	},
+	{
+		Code:        ErrorCodeUserSubmittedOverLimit,
+		HttpStatus:  http.StatusTooManyRequests,
+		GrpcCode:    codes.ResourceExhausted,
+		Description: "The user submitted over the limit.",
+	},
+	{
+		Code:        ErrorCodeUserTierTooHigh,
+		HttpStatus:  http.StatusForbidden,
+		GrpcCode:    codes.FailedPrecondition,
+		Description: "The user tier is too high for the action.",
+	},
+	{
+		Code:        ErrorCodeUserTierNotEnough,
+		HttpStatus:  http.StatusForbidden,
+		GrpcCode:    codes.FailedPrecondition,
+		Description: "The user tier is not enough for the action.",
+	},
+	{
+		Code:        ErrorCodeUserLinkedAnotherAccount,
+		HttpStatus:  http.StatusConflict,
+		GrpcCode:    codes.AlreadyExists,
+		Description: "The user is linked to another account.",
+	},
+	{
+		Code:        ErrorCodeGameRestrictedByVoucher,
+		HttpStatus:  http.StatusForbidden,
+		GrpcCode:    codes.PermissionDenied,
+		Description: "The game is restricted by the voucher.",
+	},
+}
+
+const (
+	ErrorDataKeyFields = "fields"
+)
+
+func init() {
+	gmeta.RegisterErrorCode(ErrorCodeMetas...)
+	types.ValidatorRegisterErrorConverter(NewInvalidParamsError)
+	gmeta.SetMoneyCurrencyMetaGetter(GetCurrencyMeta)
+	gmeta.SetMoneyCurrencyError(ErrorCurrency)
+}
+
+// NewInvalidParamsError carries field errors in the data so clients can highlight each field.
+func NewInvalidParamsError(fieldErrs types.ValidationFieldErrors) error {
+	return ErrorInvalidParams.
+		WithData(gmeta.O{ErrorDataKeyFields: fieldErrs}).
+		Wrap(fieldErrs)
+}
+
+// ErrorToOurError finds OurError in the chain, context timeouts become ErrorTimeout
+// and other errors become ErrorUnknown.
+func ErrorToOurError(err error) gmeta.OurError {
+	if ourErr, ok := gmeta.FindOurError(err); ok {
+		return ourErr
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorTimeout.Wrap(err)
+	}
+	return ErrorUnknown.Wrap(err)
+}
+
+func ErrorHttpStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	return ErrorToOurError(err).Code().HttpStatus()
+}
+
+func ErrorGrpcCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	return ErrorToOurError(err).Code().GrpcCode()
+}
+
+// NewErrorEnvelope returns the HTTP status and the JSON body with localized message for any error.
+func NewErrorEnvelope(ctx context.Context, err error) (int, gmeta.ErrorEnvelope) {
+	ourErr := ErrorToOurError(err)
+	return ourErr.Code().HttpStatus(), gmeta.NewErrorEnvelope(ctx, ourErr)
+}
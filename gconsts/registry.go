@@ -0,0 +1,22 @@
+package gconsts
+
+import (
+	"github.com/kiyuu10/common-lib-go/gmeta"
+)
+
+// DefaultRegistry is gmeta.DefaultRegistry seeded by CurrencyMetaMap, CurrencyIdenticalMap,
+// BlockchainNetworkMetas and BlockchainTokenMetas, applications may load more entries from files on startup.
+var DefaultRegistry = seedDefaultRegistry()
+
+func seedDefaultRegistry() *gmeta.Registry {
+	registry := gmeta.DefaultRegistry
+	for _, meta := range CurrencyMetaMap {
+		registry.RegisterCurrency(meta)
+	}
+	for currency, identicalTo := range CurrencyIdenticalMap {
+		registry.RegisterAlias(currency, identicalTo)
+	}
+	registry.RegisterNetwork(BlockchainNetworkMetas...)
+	registry.RegisterToken(BlockchainTokenMetas...)
+	return registry
+}
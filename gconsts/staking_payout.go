@@ -1,7 +1,7 @@
 package gconsts
 
 import (
-	"gitlab.com/snap-clickstaff/go-app/lib/gmeta"
+	"github.com/kiyuu10/common-lib-go/gmeta"
 )
 
 var (
@@ -0,0 +1,93 @@
+package gconsts
+
+import (
+	"github.com/kiyuu10/common-lib-go/gmeta"
+)
+
+// BlockchainTokenMetas is the seed of tokens in DefaultRegistry.
+var BlockchainTokenMetas = []gmeta.TokenMeta{
+	{
+		Currency:        CurrencyTetherUSD,
+		Network:         BlockchainNetworkEthereum,
+		ContractAddress: "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+		Standard:        gmeta.TokenStandardERC20,
+		DecimalPlaces:   6,
+	},
+	{
+		Currency:        CurrencyTetherUSD,
+		Network:         BlockchainNetworkTron,
+		ContractAddress: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+		Standard:        gmeta.TokenStandardTRC20,
+		DecimalPlaces:   6,
+	},
+	{
+		Currency:        CurrencyTetherUSD,
+		Network:         BlockchainNetworkBinanceSmartChain,
+		ContractAddress: "0x55d398326f99059fF775485246999027B3197955",
+		Standard:        gmeta.TokenStandardERC20,
+		DecimalPlaces:   18,
+	},
+	{
+		Currency:        CurrencyTetherUSD,
+		Network:         BlockchainNetworkPolygon,
+		ContractAddress: "0xc2132D05D31c914a87C6611C10748AEb04B58e8F",
+		Standard:        gmeta.TokenStandardERC20,
+		DecimalPlaces:   6,
+	},
+	{
+		Currency:        CurrencyTetherUSD,
+		Network:         BlockchainNetworkSolana,
+		ContractAddress: "Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB",
+		Standard:        gmeta.TokenStandardSPL,
+		DecimalPlaces:   6,
+	},
+	{
+		Currency:        CurrencyTetherUSD,
+		Network:         BlockchainNetworkTon,
+		ContractAddress: "EQCxE6mUtQJKFnGfaROTKOt1lZbDiiX1kCixRv7Nw2Id_sDs",
+		Standard:        gmeta.TokenStandardJetton,
+		DecimalPlaces:   6,
+	},
+	{
+		Currency:        CurrencyUSDCoin,
+		Network:         BlockchainNetworkEthereum,
+		ContractAddress: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+		Standard:        gmeta.TokenStandardERC20,
+		DecimalPlaces:   6,
+	},
+	{
+		Currency:        CurrencyUSDCoin,
+		Network:         BlockchainNetworkBinanceSmartChain,
+		ContractAddress: "0x8AC76a51cc950d9822D68b83fE1Ad97B32Cd580d",
+		Standard:        gmeta.TokenStandardERC20,
+		DecimalPlaces:   18,
+	},
+	{
+		Currency:        CurrencyUSDCoin,
+		Network:         BlockchainNetworkPolygon,
+		ContractAddress: "0x3c499c542cEF5E3811e1192ce70d8cC03d5c3359",
+		Standard:        gmeta.TokenStandardERC20,
+		DecimalPlaces:   6,
+	},
+	{
+		Currency:        CurrencyUSDCoin,
+		Network:         BlockchainNetworkSolana,
+		ContractAddress: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+		Standard:        gmeta.TokenStandardSPL,
+		DecimalPlaces:   6,
+	},
+	{
+		Currency:        CurrencyBinanceUSD,
+		Network:         BlockchainNetworkBinanceSmartChain,
+		ContractAddress: "0xe9e7CEA3DedcA5984780Bafc599bD69ADd087D56",
+		Standard:        gmeta.TokenStandardERC20,
+		DecimalPlaces:   18,
+	},
+	{
+		Currency:        CurrencyCake,
+		Network:         BlockchainNetworkBinanceSmartChain,
+		ContractAddress: "0x0E09FaBB73Bd3Ade0a17ECC321fD13a19e81cE82",
+		Standard:        gmeta.TokenStandardERC20,
+		DecimalPlaces:   18,
+	},
+}
@@ -0,0 +1,22 @@
+package gconsts
+
+import (
+	"github.com/kiyuu10/common-lib-go/gmeta"
+)
+
+// Tags are registered by gmeta where the structs using them are declared,
+// except `coin_address` which is registered by address.InstallValidator.
+const (
+	ValidatorTagCurrency      = gmeta.ValidatorTagCurrency
+	ValidatorTagNetwork       = gmeta.ValidatorTagNetwork
+	ValidatorTagCoinAddress   = "coin_address"
+	ValidatorTagDecimalGt     = gmeta.ValidatorTagDecimalGt
+	ValidatorTagDecimalGte    = gmeta.ValidatorTagDecimalGte
+	ValidatorTagDecimalLt     = gmeta.ValidatorTagDecimalLt
+	ValidatorTagDecimalLte    = gmeta.ValidatorTagDecimalLte
+	ValidatorTagDecimalPlaces = gmeta.ValidatorTagDecimalPlaces
+	ValidatorTagAmountMarkup  = gmeta.ValidatorTagAmountMarkup
+	ValidatorTagUnixTimeRange = gmeta.ValidatorTagUnixTimeRange
+
+	ValidatorUnixTimeMax = gmeta.ValidatorUnixTimeMax
+)
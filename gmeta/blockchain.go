@@ -1,9 +1,17 @@
 package gmeta
 
 import (
+	"encoding/json"
 	"strings"
 
 	"github.com/shopspring/decimal"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+const (
+	BlockchainNetworkVariantSep = ":"
+	CoinIndexCodeSep            = "@"
 )
 
 type BlockchainType string
@@ -12,6 +20,16 @@ func (t BlockchainType) IsUtxoType() bool {
 	return strings.HasPrefix(string(t), "utxo-")
 }
 
+// Networks returns networks of the type in DefaultRegistry.
+func (t BlockchainType) Networks() []BlockchainNetwork {
+	metas := DefaultRegistry.NetworksOf(t)
+	networks := make([]BlockchainNetwork, len(metas))
+	for i, meta := range metas {
+		networks[i] = meta.Code
+	}
+	return networks
+}
+
 type BlockchainNetwork string
 
 func NewBlockchainNetwork(code string) BlockchainNetwork {
@@ -26,22 +44,154 @@ func (bn BlockchainNetwork) AsLower() BlockchainNetwork {
 	return BlockchainNetwork(strings.ToLower(string(bn)))
 }
 
+func (bn BlockchainNetwork) Meta() (NetworkMeta, bool) {
+	return DefaultRegistry.Network(bn)
+}
+
+func (bn BlockchainNetwork) Type() BlockchainType {
+	meta, _ := bn.Meta()
+	return meta.Type
+}
+
+func (bn BlockchainNetwork) NativeCurrency() Currency {
+	meta, _ := bn.Meta()
+	return meta.NativeCurrency
+}
+
+// ChainID is zero for non-EVM networks.
+func (bn BlockchainNetwork) ChainID() uint64 {
+	meta, _ := bn.Meta()
+	return meta.ChainID
+}
+
+func (bn BlockchainNetwork) Confirmations() uint32 {
+	meta, _ := bn.Meta()
+	return meta.Confirmations
+}
+
+// IsTestnet falls back to the `<MAINNET>:<TESTNET>` code convention for unregistered networks.
+func (bn BlockchainNetwork) IsTestnet() bool {
+	if meta, ok := bn.Meta(); ok {
+		return meta.IsTestnet()
+	}
+	return strings.Contains(string(bn), BlockchainNetworkVariantSep)
+}
+
+// Mainnet returns the network itself if it's a mainnet.
+func (bn BlockchainNetwork) Mainnet() BlockchainNetwork {
+	if meta, ok := bn.Meta(); ok {
+		if meta.IsTestnet() {
+			return meta.Mainnet
+		}
+		return bn
+	}
+	mainnet, _, _ := strings.Cut(string(bn), BlockchainNetworkVariantSep)
+	return BlockchainNetwork(mainnet)
+}
+
+func (bn BlockchainNetwork) Testnets() []BlockchainNetwork {
+	metas := DefaultRegistry.Testnets(bn)
+	networks := make([]BlockchainNetwork, len(metas))
+	for i, meta := range metas {
+		networks[i] = meta.Code
+	}
+	return networks
+}
+
 type BlockchainCoinIndex struct {
 	Currency Currency          `json:"currency"`
 	Network  BlockchainNetwork `json:"network"`
 }
 
-func (bi *BlockchainCoinIndex) GetCurrency() Currency {
+func (bi BlockchainCoinIndex) GetCurrency() Currency {
 	return bi.Currency
 }
 
-func (bi *BlockchainCoinIndex) GetNetwork() BlockchainNetwork {
+func (bi BlockchainCoinIndex) GetNetwork() BlockchainNetwork {
 	return bi.Network
 }
 
+func (bi BlockchainCoinIndex) GetIndexCode() string {
+	return bi.String()
+}
+
+// ParseCoinIndex parses the compact code `<CURRENCY>@<NETWORK>` such as `USDT@TRX`.
+func ParseCoinIndex(code string) (BlockchainCoinIndex, error) {
+	currency, network, ok := strings.Cut(code, CoinIndexCodeSep)
+	if !ok || currency == "" || network == "" || strings.Contains(network, CoinIndexCodeSep) {
+		return BlockchainCoinIndex{}, erroy.New("coin index: invalid code").WithField("code", code)
+	}
+	return BlockchainCoinIndex{
+		Currency: Currency(currency),
+		Network:  BlockchainNetwork(network),
+	}, nil
+}
+
+func MustParseCoinIndex(code string) BlockchainCoinIndex {
+	index, err := ParseCoinIndex(code)
+	if err != nil {
+		panic(err)
+	}
+	return index
+}
+
+func (bi BlockchainCoinIndex) IsZero() bool {
+	return bi.Currency == "" && bi.Network == ""
+}
+
+// String is empty for the zero index so an unset index is encoded as `""`.
+func (bi BlockchainCoinIndex) String() string {
+	if bi.IsZero() {
+		return ""
+	}
+	return bi.Currency.String() + CoinIndexCodeSep + bi.Network.String()
+}
+
+func (bi BlockchainCoinIndex) NetworkCurrency() NetworkCurrency {
+	return NetworkCurrency{
+		Network:  bi.Network,
+		Currency: bi.Currency,
+	}
+}
+
+// MarshalText makes the index encoded as the compact code in JSON and map keys.
+func (bi BlockchainCoinIndex) MarshalText() ([]byte, error) {
+	return []byte(bi.String()), nil
+}
+
+// UnmarshalText decodes an empty text as the zero index.
+func (bi *BlockchainCoinIndex) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*bi = BlockchainCoinIndex{}
+		return nil
+	}
+	*bi, err = ParseCoinIndex(string(text))
+	return
+}
+
+// UnmarshalJSON also accepts the legacy object form `{"currency":...,"network":...}`.
+func (bi *BlockchainCoinIndex) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '{' {
+		type tLegacyIndex BlockchainCoinIndex
+		var legacyIndex tLegacyIndex
+		if err := json.Unmarshal(data, &legacyIndex); err != nil {
+			return erroy.WrapMessage(err, "coin index: decode json")
+		}
+		*bi = BlockchainCoinIndex(legacyIndex)
+		return nil
+	}
+	var code string
+	if err := json.Unmarshal(data, &code); err != nil {
+		return erroy.WrapMessage(err, "coin index: decode json")
+	}
+	return bi.UnmarshalText([]byte(code))
+}
+
+// Domain validator tags (`network`, `currency`, `decimal_gt`...) are registered in validator.go,
+// `network` and `currency` check DefaultRegistry once it has entries.
 type NetworkCurrency struct {
-	Network  BlockchainNetwork `json:"network" validate:"required"`
-	Currency Currency          `json:"currency" validate:"required"`
+	Network  BlockchainNetwork `json:"network" validate:"required,network"`
+	Currency Currency          `json:"currency" validate:"required,currency"`
 }
 
 func (nc NetworkCurrency) GetCurrency() Currency {
@@ -53,20 +203,32 @@ func (nc NetworkCurrency) GetNetwork() BlockchainNetwork {
 }
 
 func (nc NetworkCurrency) GetIndexCode() string {
-	return nc.GetCurrency().String() + "@" + nc.GetNetwork().String()
+	return nc.GetCurrency().String() + CoinIndexCodeSep + nc.GetNetwork().String()
+}
+
+func (nc NetworkCurrency) CoinIndex() BlockchainCoinIndex {
+	return BlockchainCoinIndex{
+		Currency: nc.Currency,
+		Network:  nc.Network,
+	}
+}
+
+// Token returns the token meta of the coin in DefaultRegistry.
+func (nc NetworkCurrency) Token() (TokenMeta, bool) {
+	return DefaultRegistry.Token(nc)
 }
 
 type NetworkCurrencyAmount struct {
-	Network  BlockchainNetwork `json:"network" validate:"required"`
-	Currency Currency          `json:"currency" validate:"required"`
-	Value    decimal.Decimal   `json:"value" validate:"required"`
+	Network  BlockchainNetwork `json:"network" validate:"required,network"`
+	Currency Currency          `json:"currency" validate:"required,currency"`
+	Value    decimal.Decimal   `json:"value" validate:"required,decimal_gt=0,decimal_places=Currency"`
 }
 
 type NetworkCurrencyAmountUSD struct {
-	Network       BlockchainNetwork `json:"network" validate:"required"`
-	Currency      Currency          `json:"currency" validate:"required"`
-	CurrencyValue decimal.Decimal   `json:"currency_value" validate:"required"`
-	USDValue      decimal.Decimal   `json:"usd_value" validate:"required"`
+	Network       BlockchainNetwork `json:"network" validate:"required,network"`
+	Currency      Currency          `json:"currency" validate:"required,currency"`
+	CurrencyValue decimal.Decimal   `json:"currency_value" validate:"required,decimal_gt=0,decimal_places=Currency"`
+	USDValue      decimal.Decimal   `json:"usd_value" validate:"required,decimal_gte=0"`
 }
 
 type BlockchainTypedDataDomain struct {
@@ -0,0 +1,41 @@
+package gmeta
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBlockchainCoinIndexJson(t *testing.T) {
+	type tHolder struct {
+		Index BlockchainCoinIndex `json:"index"`
+	}
+	tests := []struct {
+		name  string
+		index BlockchainCoinIndex
+		json  string
+	}{
+		{"zero", BlockchainCoinIndex{}, `{"index":""}`},
+		{"code", MustParseCoinIndex("USDT@TRX"), `{"index":"USDT@TRX"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tHolder{Index: tt.index})
+			if err != nil || string(data) != tt.json {
+				t.Fatalf("Marshal = %s, %v, want %s", data, err, tt.json)
+			}
+			var holder tHolder
+			if err := json.Unmarshal(data, &holder); err != nil || holder.Index != tt.index {
+				t.Fatalf("Unmarshal = %+v, %v, want %+v", holder.Index, err, tt.index)
+			}
+		})
+	}
+
+	var holder tHolder
+	if err := json.Unmarshal([]byte(`{"index":{"currency":"USDT","network":"TRX"}}`), &holder); err != nil ||
+		holder.Index != MustParseCoinIndex("USDT@TRX") {
+		t.Fatalf("legacy object is decoded as %+v, %v", holder.Index, err)
+	}
+	if err := json.Unmarshal([]byte(`{"index":"@"}`), &holder); err == nil {
+		t.Fatal("an invalid code must fail")
+	}
+}
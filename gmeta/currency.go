@@ -1,11 +1,13 @@
 package gmeta
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/shopspring/decimal"
 
-	comutils "gitea.alchemymagic.app/snap/go-common/utils"
+	"github.com/kiyuu10/common-lib-go/erroy"
+	comutils "github.com/kiyuu10/common-lib-go/utils"
 )
 
 type Currency string
@@ -40,8 +42,10 @@ func NewCurrencyU(code string) Currency {
 
 type (
 	CurrencyMeta struct {
-		Code          Currency
-		DecimalPlaces uint8
+		Code          Currency     `json:"code" yaml:"code"`
+		DecimalPlaces uint8        `json:"decimal_places" yaml:"decimal_places"`
+		Symbol        string       `json:"symbol,omitempty" yaml:"symbol,omitempty"`
+		Kind          CurrencyKind `json:"kind" yaml:"kind"`
 	}
 	CurrencyConversionRate struct {
 		FromCurrency Currency `json:"from_currency"`
@@ -49,7 +53,7 @@ type (
 		Exponent     int32    `json:"exponent"`
 	}
 	CurrencyAmount struct {
-		Currency Currency        `gorm:"column:currency" json:"currency" validate:"required"`
+		Currency Currency        `gorm:"column:currency" json:"currency" validate:"required,currency"`
 		Value    decimal.Decimal `gorm:"column:value" json:"value" validate:"required"`
 	}
 )
@@ -118,3 +122,31 @@ func (am AmountMarkup) MarshalBinary() ([]byte, error) {
 func (am *AmountMarkup) UnmarshalBinary(data []byte) (err error) {
 	return am.UnmarshalText(data)
 }
+
+// NewCurrencyErrorMap keeps the first error of each key of the multi error.
+func NewCurrencyErrorMap(multiErr *erroy.MultiError) CurrencyErrorMap {
+	errMap := make(CurrencyErrorMap, multiErr.Len())
+	for _, item := range multiErr.Items() {
+		currency := Currency(item.Key)
+		if _, ok := errMap[currency]; !ok {
+			errMap[currency] = item.Err
+		}
+	}
+	return errMap
+}
+
+// MultiError returns errors ordered by currency.
+func (m CurrencyErrorMap) MultiError() *erroy.MultiError {
+	currencies := make([]Currency, 0, len(m))
+	for currency := range m {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool {
+		return currencies[i] < currencies[j]
+	})
+	multiErr := erroy.NewMultiError()
+	for _, currency := range currencies {
+		multiErr.Add(currency.String(), m[currency])
+	}
+	return multiErr
+}
@@ -0,0 +1,232 @@
+package gmeta
+
+import (
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+var (
+	ErrCurrencyConversionNotFound     = errors.New("currency converter: conversion not found")
+	ErrCurrencyConversionInconsistent = errors.New("currency converter: inconsistent rate")
+	ErrCurrencyConversionImprecise    = errors.New("currency converter: imprecise conversion")
+)
+
+type tCurrencyEdge struct {
+	to       Currency
+	exponent int32
+}
+
+// CurrencyConverter converts amounts between units linked directly or through other units by rates.
+// Every unit has an exponent relative to the first unit of its component,
+// so a rate contradicting existing paths is detected when it's added.
+// A root is a unit which isn't the smaller side of any rate, units convert only under a common root,
+// so a sub-unit shared by two currencies (BTC and LTC to satoshi) doesn't link them.
+// A rate with exponent 0 is an alias, both sides belong to the same roots.
+type CurrencyConverter struct {
+	mux       sync.RWMutex
+	edges     map[Currency][]tCurrencyEdge
+	exponents map[Currency]int32
+	roots     map[Currency][]Currency
+
+	// ErrorThreshold is the minimum ratio between the integer result and the exact result of ConvertInteger,
+	// it must be set before the converter is used.
+	ErrorThreshold decimal.Decimal
+}
+
+func NewCurrencyConverter(rates ...CurrencyConversionRate) (*CurrencyConverter, error) {
+	converter := &CurrencyConverter{
+		edges:     make(map[Currency][]tCurrencyEdge),
+		exponents: make(map[Currency]int32),
+		roots:     make(map[Currency][]Currency),
+	}
+	if err := converter.AddRates(rates...); err != nil {
+		return nil, err
+	}
+	return converter, nil
+}
+
+func NewCurrencyConverterF(rates ...CurrencyConversionRate) *CurrencyConverter {
+	converter, err := NewCurrencyConverter(rates...)
+	if err != nil {
+		panic(err)
+	}
+	return converter
+}
+
+// AddRates is safe for concurrent use, no rate is added if any of them is inconsistent.
+func (c *CurrencyConverter) AddRates(rates ...CurrencyConversionRate) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	edges := make(map[Currency][]tCurrencyEdge, len(c.edges))
+	for currency, currencyEdges := range c.edges {
+		edges[currency] = append([]tCurrencyEdge(nil), currencyEdges...)
+	}
+	for _, rate := range rates {
+		edges[rate.FromCurrency] = append(edges[rate.FromCurrency], tCurrencyEdge{to: rate.ToCurrency, exponent: rate.Exponent})
+		edges[rate.ToCurrency] = append(edges[rate.ToCurrency], tCurrencyEdge{to: rate.FromCurrency, exponent: -rate.Exponent})
+	}
+	exponents, err := resolveCurrencyExponents(edges)
+	if err != nil {
+		return err
+	}
+	c.edges, c.exponents, c.roots = edges, exponents, resolveCurrencyRoots(edges)
+	return nil
+}
+
+func resolveCurrencyExponents(edges map[Currency][]tCurrencyEdge) (map[Currency]int32, error) {
+	var (
+		exponents = make(map[Currency]int32, len(edges))
+		visited   = make(map[Currency]bool, len(edges))
+	)
+	for root := range edges {
+		if visited[root] {
+			continue
+		}
+		exponents[root], visited[root] = 0, true
+		queue := []Currency{root}
+		for len(queue) > 0 {
+			currency := queue[0]
+			queue = queue[1:]
+			for _, edge := range edges[currency] {
+				exponent := exponents[currency] + edge.exponent
+				if !visited[edge.to] {
+					exponents[edge.to], visited[edge.to] = exponent, true
+					queue = append(queue, edge.to)
+					continue
+				}
+				if exponents[edge.to] != exponent {
+					return nil, erroy.Wrap(ErrCurrencyConversionInconsistent).
+						WithField("from_currency", currency).
+						WithField("to_currency", edge.to)
+				}
+			}
+		}
+	}
+	return exponents, nil
+}
+
+// resolveCurrencyRoots returns the sorted roots of every unit,
+// a unit belongs to the roots reaching it through rates to smaller units or aliases.
+func resolveCurrencyRoots(edges map[Currency][]tCurrencyEdge) map[Currency][]Currency {
+	subUnits := make(map[Currency]bool, len(edges))
+	for _, currencyEdges := range edges {
+		for _, edge := range currencyEdges {
+			if edge.exponent > 0 {
+				subUnits[edge.to] = true
+			}
+		}
+	}
+	roots := make(map[Currency][]Currency, len(edges))
+	for root := range edges {
+		if subUnits[root] {
+			continue
+		}
+		visited := map[Currency]bool{root: true}
+		queue := []Currency{root}
+		for len(queue) > 0 {
+			currency := queue[0]
+			queue = queue[1:]
+			roots[currency] = append(roots[currency], root)
+			for _, edge := range edges[currency] {
+				if edge.exponent >= 0 && !visited[edge.to] {
+					visited[edge.to] = true
+					queue = append(queue, edge.to)
+				}
+			}
+		}
+	}
+	for _, currencyRoots := range roots {
+		slices.Sort(currencyRoots)
+	}
+	return roots
+}
+
+// commonRoot must be called with the lock held.
+func (c *CurrencyConverter) commonRoot(from Currency, to Currency) (Currency, bool) {
+	for _, root := range c.roots[from] {
+		if slices.Contains(c.roots[to], root) {
+			return root, true
+		}
+	}
+	return "", false
+}
+
+// Exponent returns `e` so that an amount of `from` equals amount * 10^e of `to`.
+func (c *CurrencyConverter) Exponent(from Currency, to Currency) (int32, error) {
+	if from == to {
+		return 0, nil
+	}
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	if _, ok := c.commonRoot(from, to); !ok {
+		return 0, erroy.Wrap(ErrCurrencyConversionNotFound).
+			WithField("from_currency", from).
+			WithField("to_currency", to)
+	}
+	return c.exponents[to] - c.exponents[from], nil
+}
+
+// Path returns the shortest chain of units from `from` to `to` under their common root, both included.
+func (c *CurrencyConverter) Path(from Currency, to Currency) ([]Currency, error) {
+	if _, err := c.Exponent(from, to); err != nil {
+		return nil, err
+	}
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	root, _ := c.commonRoot(from, to)
+	var (
+		previous = map[Currency]Currency{from: from}
+		queue    = []Currency{from}
+	)
+	for len(queue) > 0 && previous[to] == "" {
+		currency := queue[0]
+		queue = queue[1:]
+		for _, edge := range c.edges[currency] {
+			if _, visited := previous[edge.to]; !visited && slices.Contains(c.roots[edge.to], root) {
+				previous[edge.to] = currency
+				queue = append(queue, edge.to)
+			}
+		}
+	}
+	path := []Currency{to}
+	for currency := to; currency != from; {
+		currency = previous[currency]
+		path = append([]Currency{currency}, path...)
+	}
+	return path, nil
+}
+
+// Convert is exact since rates are powers of ten.
+func (c *CurrencyConverter) Convert(amount decimal.Decimal, from Currency, to Currency) (decimal.Decimal, error) {
+	exponent, err := c.Exponent(from, to)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return amount.Shift(exponent), nil
+}
+
+// ConvertInteger truncates the result to an integer of `to` (usually the smallest unit),
+// the truncation must keep at least ErrorThreshold of the exact result.
+func (c *CurrencyConverter) ConvertInteger(amount decimal.Decimal, from Currency, to Currency) (decimal.Decimal, error) {
+	exact, err := c.Convert(amount, from, to)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	truncated := exact.Truncate(0)
+	if exact.IsZero() || truncated.Equal(exact) || c.ErrorThreshold.IsZero() {
+		return truncated, nil
+	}
+	if truncated.Div(exact).LessThan(c.ErrorThreshold) {
+		return decimal.Zero, erroy.Wrap(ErrCurrencyConversionImprecise).
+			WithField("amount", amount).
+			WithField("from_currency", from).
+			WithField("to_currency", to)
+	}
+	return truncated, nil
+}
@@ -0,0 +1,147 @@
+package gmeta
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestCurrencyConverterExponent(t *testing.T) {
+	converter := NewCurrencyConverterF(
+		CurrencyConversionRate{FromCurrency: "BTC", ToCurrency: "satoshi", Exponent: 8},
+		CurrencyConversionRate{FromCurrency: "LTC", ToCurrency: "satoshi", Exponent: 8},
+		CurrencyConversionRate{FromCurrency: "ETH", ToCurrency: "gwei", Exponent: 9},
+		CurrencyConversionRate{FromCurrency: "gwei", ToCurrency: "wei", Exponent: 9},
+		CurrencyConversionRate{FromCurrency: "USDC", ToCurrency: "micro_usdc", Exponent: 6},
+		CurrencyConversionRate{FromCurrency: "USDC.e", ToCurrency: "USDC", Exponent: 0},
+	)
+	tests := []struct {
+		name     string
+		from     Currency
+		to       Currency
+		exponent int32
+		err      error
+	}{
+		{"same unit", "BTC", "BTC", 0, nil},
+		{"direct", "BTC", "satoshi", 8, nil},
+		{"reversed", "satoshi", "BTC", -8, nil},
+		{"shared sub-unit", "LTC", "satoshi", 8, nil},
+		{"roots of a shared sub-unit", "BTC", "LTC", 0, ErrCurrencyConversionNotFound},
+		{"multi-hop", "ETH", "wei", 18, nil},
+		{"multi-hop reversed", "wei", "ETH", -18, nil},
+		{"alias", "USDC.e", "USDC", 0, nil},
+		{"through an alias", "USDC.e", "micro_usdc", 6, nil},
+		{"other component", "ETH", "satoshi", 0, ErrCurrencyConversionNotFound},
+		{"unknown unit", "BTC", "DOGE", 0, ErrCurrencyConversionNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exponent, err := converter.Exponent(tt.from, tt.to)
+			if !errors.Is(err, tt.err) || exponent != tt.exponent {
+				t.Fatalf("Exponent(%s, %s) = %d, %v, want %d, %v", tt.from, tt.to, exponent, err, tt.exponent, tt.err)
+			}
+		})
+	}
+}
+
+func TestCurrencyConverterPath(t *testing.T) {
+	converter := NewCurrencyConverterF(
+		CurrencyConversionRate{FromCurrency: "BTC", ToCurrency: "satoshi", Exponent: 8},
+		CurrencyConversionRate{FromCurrency: "LTC", ToCurrency: "satoshi", Exponent: 8},
+		CurrencyConversionRate{FromCurrency: "ETH", ToCurrency: "gwei", Exponent: 9},
+		CurrencyConversionRate{FromCurrency: "gwei", ToCurrency: "wei", Exponent: 9},
+	)
+	tests := []struct {
+		from Currency
+		to   Currency
+		path []Currency
+	}{
+		{"ETH", "wei", []Currency{"ETH", "gwei", "wei"}},
+		{"wei", "ETH", []Currency{"wei", "gwei", "ETH"}},
+		{"LTC", "satoshi", []Currency{"LTC", "satoshi"}},
+	}
+	for _, tt := range tests {
+		path, err := converter.Path(tt.from, tt.to)
+		if err != nil || !slices.Equal(path, tt.path) {
+			t.Fatalf("Path(%s, %s) = %v, %v, want %v", tt.from, tt.to, path, err, tt.path)
+		}
+	}
+	if _, err := converter.Path("BTC", "LTC"); !errors.Is(err, ErrCurrencyConversionNotFound) {
+		t.Fatalf("Path(BTC, LTC) error = %v, want not found", err)
+	}
+}
+
+func TestCurrencyConverterInconsistent(t *testing.T) {
+	tests := []struct {
+		name  string
+		rates []CurrencyConversionRate
+	}{
+		{"contradicting path", []CurrencyConversionRate{
+			{FromCurrency: "ETH", ToCurrency: "gwei", Exponent: 9},
+			{FromCurrency: "gwei", ToCurrency: "wei", Exponent: 9},
+			{FromCurrency: "ETH", ToCurrency: "wei", Exponent: 17},
+		}},
+		{"contradicting duplicate", []CurrencyConversionRate{
+			{FromCurrency: "BTC", ToCurrency: "satoshi", Exponent: 8},
+			{FromCurrency: "satoshi", ToCurrency: "BTC", Exponent: 8},
+		}},
+		{"contradicting alias", []CurrencyConversionRate{
+			{FromCurrency: "USDC", ToCurrency: "micro_usdc", Exponent: 6},
+			{FromCurrency: "USDC.e", ToCurrency: "USDC", Exponent: 0},
+			{FromCurrency: "USDC.e", ToCurrency: "micro_usdc", Exponent: 5},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewCurrencyConverter(tt.rates...); !errors.Is(err, ErrCurrencyConversionInconsistent) {
+				t.Fatalf("NewCurrencyConverter error = %v, want inconsistent", err)
+			}
+		})
+	}
+}
+
+func TestCurrencyConverterAddRatesRollback(t *testing.T) {
+	converter := NewCurrencyConverterF(CurrencyConversionRate{FromCurrency: "BTC", ToCurrency: "satoshi", Exponent: 8})
+	err := converter.AddRates(
+		CurrencyConversionRate{FromCurrency: "TRX", ToCurrency: "sun", Exponent: 6},
+		CurrencyConversionRate{FromCurrency: "BTC", ToCurrency: "satoshi", Exponent: 7},
+	)
+	if !errors.Is(err, ErrCurrencyConversionInconsistent) {
+		t.Fatalf("AddRates error = %v, want inconsistent", err)
+	}
+	if _, err := converter.Exponent("TRX", "sun"); !errors.Is(err, ErrCurrencyConversionNotFound) {
+		t.Fatalf("a rate of a failed AddRates is kept, error = %v", err)
+	}
+	if exponent, err := converter.Exponent("BTC", "satoshi"); err != nil || exponent != 8 {
+		t.Fatalf("Exponent(BTC, satoshi) = %d, %v after a failed AddRates", exponent, err)
+	}
+
+	if err := converter.AddRates(CurrencyConversionRate{FromCurrency: "TRX", ToCurrency: "sun", Exponent: 6}); err != nil {
+		t.Fatalf("AddRates error = %v", err)
+	}
+	if exponent, err := converter.Exponent("sun", "TRX"); err != nil || exponent != -6 {
+		t.Fatalf("Exponent(sun, TRX) = %d, %v", exponent, err)
+	}
+}
+
+func TestCurrencyConverterConvertInteger(t *testing.T) {
+	converter := NewCurrencyConverterF(CurrencyConversionRate{FromCurrency: "BTC", ToCurrency: "satoshi", Exponent: 8})
+	converter.ErrorThreshold = decimal.RequireFromString("0.99")
+	tests := []struct {
+		amount string
+		result string
+		err    error
+	}{
+		{"1.5", "150000000", nil},
+		{"0.000000015", "1", ErrCurrencyConversionImprecise},
+		{"0.0000001005", "10", nil},
+	}
+	for _, tt := range tests {
+		result, err := converter.ConvertInteger(decimal.RequireFromString(tt.amount), "BTC", "satoshi")
+		if !errors.Is(err, tt.err) || (err == nil && result.String() != tt.result) {
+			t.Fatalf("ConvertInteger(%s) = %s, %v, want %s, %v", tt.amount, result, err, tt.result, tt.err)
+		}
+	}
+}
@@ -2,11 +2,12 @@ package gmeta
 
 import (
 	"database/sql/driver"
+	"reflect"
 	"strings"
 
-	"gitea.alchemymagic.app/snap/go-common/erroy"
-	"gitea.alchemymagic.app/snap/go-common/types"
-	comutils "gitea.alchemymagic.app/snap/go-common/utils"
+	"github.com/kiyuu10/common-lib-go/erroy"
+	"github.com/kiyuu10/common-lib-go/types"
+	comutils "github.com/kiyuu10/common-lib-go/utils"
 )
 
 const (
@@ -16,6 +17,10 @@ const (
 	EncryptedSecretLength = 32
 )
 
+func init() {
+	erroy.RegisterRedactedType(reflect.TypeFor[EncryptedValue]())
+}
+
 type EncryptedValue struct {
 	cipherText string
 	secret     types.Secret
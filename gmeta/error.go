@@ -2,14 +2,12 @@ package gmeta
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
-	"gitea.alchemymagic.app/snap/go-common/locale"
-	"gitea.alchemymagic.app/snap/go-common/logging"
-
-	comutils "gitea.alchemymagic.app/snap/go-common/utils"
-	"gitlab.com/snap-clickstaff/go-app/config"
+	"github.com/kiyuu10/common-lib-go/config"
+	"github.com/kiyuu10/common-lib-go/locale"
+	"github.com/kiyuu10/common-lib-go/logging"
+	comutils "github.com/kiyuu10/common-lib-go/utils"
 )
 
 type ErrorCode string
@@ -44,6 +42,22 @@ func (e OurError) Code() ErrorCode {
 	return e.code
 }
 
+// ErrorCode implements erroy.Coder.
+func (e OurError) ErrorCode() string {
+	return e.code.String()
+}
+
+// Retryable implements erroy.Retryabler by the registered meta of the code.
+func (e OurError) Retryable() bool {
+	meta, ok := GetErrorCodeMeta(e.code)
+	return ok && meta.Retryable
+}
+
+// UserFacing implements erroy.UserFacer, messages of codes are translated for end users.
+func (e OurError) UserFacing() bool {
+	return true
+}
+
 func (e OurError) Wrap(err error) OurError {
 	e.err = err
 	return e
@@ -67,6 +81,10 @@ func (e OurError) WithData(data O) OurError {
 	return e
 }
 
+func (e OurError) Data() O {
+	return e.messageData
+}
+
 func (e OurError) WithKey(key string) OurError {
 	return e.WithMessage(key, nil)
 }
@@ -94,19 +112,14 @@ func (e OurError) Message(ctx context.Context) string {
 		WithField("key", errKey).
 		WithError(err).
 		Warn("translation failed")
-	if config.Test {
+	if config.IsTest() {
 		message = fmt.Sprintf(
 			"translate key `%s` failed | data=%s,err=%v",
 			errKey, comutils.JsonEncodeF(e.messageData), err,
 		)
 		return message
 	}
-	if errors.Is(err, locale.ErrNotFound) {
-		message, err := locale.TranslateKeyData(nil, errKey, e.messageData)
-		if err == nil {
-			return message
-		}
-	}
+	// The default language is already the last fallback of TranslateKeyData.
 	return "An unexpected error occurred!"
 }
 
@@ -0,0 +1,119 @@
+package gmeta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ErrorCodeCurrency is the catalog code of gconsts.ErrorCurrency, gmeta uses it for defaults before gconsts is initialized.
+const ErrorCodeCurrency ErrorCode = "error_currency"
+
+type ErrorCodeMeta struct {
+	Code        ErrorCode  `json:"code"`
+	HttpStatus  int        `json:"http_status"`
+	GrpcCode    codes.Code `json:"grpc_code"`
+	Retryable   bool       `json:"retryable"`
+	Description string     `json:"description"`
+}
+
+var (
+	vErrorCodeMetaMux sync.RWMutex
+	vErrorCodeMetaMap = make(map[ErrorCode]ErrorCodeMeta)
+)
+
+// RegisterErrorCode adds or replaces the meta of a code, applications register their own codes on initialization.
+func RegisterErrorCode(metas ...ErrorCodeMeta) {
+	vErrorCodeMetaMux.Lock()
+	defer vErrorCodeMetaMux.Unlock()
+	for _, meta := range metas {
+		vErrorCodeMetaMap[meta.Code] = meta
+	}
+}
+
+func GetErrorCodeMeta(code ErrorCode) (_ ErrorCodeMeta, exists bool) {
+	vErrorCodeMetaMux.RLock()
+	defer vErrorCodeMetaMux.RUnlock()
+	meta, exists := vErrorCodeMetaMap[code]
+	return meta, exists
+}
+
+// ListErrorCodeMetas returns all registered codes sorted by code.
+func ListErrorCodeMetas() []ErrorCodeMeta {
+	vErrorCodeMetaMux.RLock()
+	metas := make([]ErrorCodeMeta, 0, len(vErrorCodeMetaMap))
+	for _, meta := range vErrorCodeMetaMap {
+		metas = append(metas, meta)
+	}
+	vErrorCodeMetaMux.RUnlock()
+	sort.Slice(metas, func(i, j int) bool {
+		return metas[i].Code < metas[j].Code
+	})
+	return metas
+}
+
+func (ec ErrorCode) HttpStatus() int {
+	if meta, ok := GetErrorCodeMeta(ec); ok {
+		return meta.HttpStatus
+	}
+	return http.StatusInternalServerError
+}
+
+func (ec ErrorCode) GrpcCode() codes.Code {
+	if meta, ok := GetErrorCodeMeta(ec); ok {
+		return meta.GrpcCode
+	}
+	return codes.Unknown
+}
+
+// DataLocalizer is implemented by error data values which contain messages, such as types.ValidationFieldErrors.
+type DataLocalizer interface {
+	LocalizeData(ctx context.Context) any
+}
+
+// ErrorEnvelope is the standard JSON body of a failed request.
+type ErrorEnvelope struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+	Data    O         `json:"data,omitempty"`
+}
+
+// FindOurError walks the error chain to find the first OurError.
+func FindOurError(err error) (ourErr OurError, ok bool) {
+	ok = errors.As(err, &ourErr)
+	return
+}
+
+// AsOurError converts any error to OurError, `fallback` is used when the chain doesn't contain one.
+func AsOurError(err error, fallback OurError) OurError {
+	if ourErr, ok := FindOurError(err); ok {
+		return ourErr
+	}
+	return fallback.Wrap(err)
+}
+
+func NewErrorEnvelope(ctx context.Context, ourErr OurError) ErrorEnvelope {
+	return ErrorEnvelope{
+		Code:    ourErr.Code(),
+		Message: ourErr.Message(ctx),
+		Data:    localizeData(ctx, ourErr.Data()),
+	}
+}
+
+func localizeData(ctx context.Context, data O) O {
+	if len(data) == 0 {
+		return data
+	}
+	localized := make(O, len(data))
+	for key, value := range data {
+		if localizer, ok := value.(DataLocalizer); ok {
+			value = localizer.LocalizeData(ctx)
+		}
+		localized[key] = value
+	}
+	return localized
+}
@@ -4,16 +4,21 @@ import (
 	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/x509"
+	"reflect"
 
 	"github.com/golang-jwt/jwt/v4"
 
-	"gitea.alchemymagic.app/snap/go-common/erroy"
+	"github.com/kiyuu10/common-lib-go/erroy"
 )
 
 const (
 	JwtEcKeyTextPrefix = "der:"
 )
 
+func init() {
+	erroy.RegisterRedactedType(reflect.TypeFor[JwtPrivateKeyECDSA](), reflect.TypeFor[JwtKeyPair]())
+}
+
 type JwtPrivateKeyECDSA struct {
 	Key *ecdsa.PrivateKey
 }
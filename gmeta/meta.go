@@ -11,6 +11,6 @@ type (
 )
 
 type TimeRange struct {
-	FromTime int64 `json:"from_time" validate:"required"`
-	ToTime   int64 `json:"to_time" validate:"required"`
+	FromTime int64 `json:"from_time" validate:"required,unix_time_range"`
+	ToTime   int64 `json:"to_time" validate:"required,unix_time_range"`
 }
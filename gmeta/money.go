@@ -0,0 +1,314 @@
+package gmeta
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+	comutils "github.com/kiyuu10/common-lib-go/utils"
+)
+
+const (
+	MoneyDefaultDecimalPlaces = 18
+
+	moneyTextSeparator = " "
+)
+
+type RoundingMode uint8
+
+const (
+	// RoundingHalfEven is the banker's rounding, it's the default mode.
+	RoundingHalfEven RoundingMode = iota
+	// RoundingHalfUp rounds half away from zero.
+	RoundingHalfUp
+	RoundingFloor
+	RoundingCeil
+)
+
+func (m RoundingMode) Round(value decimal.Decimal, places int32) decimal.Decimal {
+	switch m {
+	case RoundingHalfUp:
+		return value.Round(places)
+	case RoundingFloor:
+		return value.RoundFloor(places)
+	case RoundingCeil:
+		return value.RoundCeil(places)
+	default:
+		return value.RoundBank(places)
+	}
+}
+
+var (
+	vMoneyCurrencyMetaGetter = func(Currency) (CurrencyMeta, bool) {
+		return CurrencyMeta{}, false
+	}
+	vMoneyCurrencyError = NewOurError(ErrorCodeCurrency)
+)
+
+// SetMoneyCurrencyMetaGetter must be called on initialization, gconsts sets GetCurrencyMeta.
+func SetMoneyCurrencyMetaGetter(getter func(currency Currency) (CurrencyMeta, bool)) {
+	vMoneyCurrencyMetaGetter = getter
+}
+
+// SetMoneyCurrencyError must be called on initialization, gconsts sets ErrorCurrency.
+func SetMoneyCurrencyError(ourErr OurError) {
+	vMoneyCurrencyError = ourErr
+}
+
+// MoneyDecimalPlaces falls back to MoneyDefaultDecimalPlaces for currencies without meta.
+func MoneyDecimalPlaces(currency Currency) int32 {
+	if meta, ok := vMoneyCurrencyMetaGetter(currency); ok {
+		return int32(meta.DecimalPlaces)
+	}
+	return MoneyDefaultDecimalPlaces
+}
+
+// Money is an amount which always has at most the decimal places of its currency,
+// constructors taking a RoundingMode round the amount while decoding fails on extra decimal places.
+type Money struct {
+	currency Currency
+	amount   decimal.Decimal
+}
+
+func NewMoney(currency Currency, amount decimal.Decimal, mode RoundingMode) Money {
+	return Money{
+		currency: currency,
+		amount:   mode.Round(amount, MoneyDecimalPlaces(currency)),
+	}
+}
+
+func NewMoneyFromString(currency Currency, amount string, mode RoundingMode) (Money, error) {
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return Money{}, erroy.WrapMessage(err, "money: parse amount").WithField("amount", amount)
+	}
+	return NewMoney(currency, value, mode), nil
+}
+
+// NewMoneyExact fails instead of rounding if the amount has more decimal places than the currency.
+func NewMoneyExact(currency Currency, amount decimal.Decimal) (Money, error) {
+	if err := checkMoneyDecimalPlaces(currency, amount); err != nil {
+		return Money{}, err
+	}
+	return Money{currency: currency, amount: amount}, nil
+}
+
+func checkMoneyDecimalPlaces(currency Currency, amount decimal.Decimal) error {
+	places := MoneyDecimalPlaces(currency)
+	if amount.Equal(amount.Truncate(places)) {
+		return nil
+	}
+	return erroy.New("money: too many decimal places").
+		WithField("currency", currency).
+		WithField("amount", amount).
+		WithField("decimal_places", places)
+}
+
+func ZeroMoney(currency Currency) Money {
+	return Money{currency: currency}
+}
+
+// ParseMoney parses the text format `<amount> <currency>`, the amount isn't rounded
+// so it fails if the amount has more decimal places than the currency.
+func ParseMoney(text string) (Money, error) {
+	amount, currency, ok := strings.Cut(strings.TrimSpace(text), moneyTextSeparator)
+	if !ok || currency == "" {
+		return Money{}, erroy.New("money: invalid text").WithField("text", text)
+	}
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return Money{}, erroy.WrapMessage(err, "money: parse amount").WithField("amount", amount)
+	}
+	return NewMoneyExact(Currency(strings.TrimSpace(currency)), value)
+}
+
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+func (m Money) Amount() decimal.Decimal {
+	return m.amount
+}
+
+func (m Money) DecimalPlaces() int32 {
+	return MoneyDecimalPlaces(m.currency)
+}
+
+func (m Money) IsZero() bool {
+	return m.amount.IsZero()
+}
+
+func (m Money) IsNegative() bool {
+	return m.amount.IsNegative()
+}
+
+func (m Money) String() string {
+	return m.amount.StringFixed(m.DecimalPlaces()) + moneyTextSeparator + m.currency.String()
+}
+
+func (m Money) CurrencyAmount() CurrencyAmount {
+	return CurrencyAmount{
+		Currency: m.currency,
+		Value:    m.amount,
+	}
+}
+
+func (ca CurrencyAmount) Money(mode RoundingMode) Money {
+	return NewMoney(ca.Currency, ca.Value, mode)
+}
+
+func (m Money) checkCurrency(other Money) error {
+	if m.currency == other.currency {
+		return nil
+	}
+	return vMoneyCurrencyError.Wrap(
+		erroy.New("money: currency mismatch").
+			WithField("currency", m.currency).
+			WithField("other_currency", other.currency),
+	)
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	m.amount = m.amount.Add(other.amount)
+	return m, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	m.amount = m.amount.Sub(other.amount)
+	return m, nil
+}
+
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return 0, err
+	}
+	return m.amount.Cmp(other.amount), nil
+}
+
+func (m Money) Equal(other Money) bool {
+	return m.currency == other.currency && m.amount.Equal(other.amount)
+}
+
+func (m Money) Mul(factor decimal.Decimal, mode RoundingMode) Money {
+	return NewMoney(m.currency, m.amount.Mul(factor), mode)
+}
+
+func (m Money) Div(divisor decimal.Decimal, mode RoundingMode) (Money, error) {
+	if divisor.IsZero() {
+		return Money{}, erroy.New("money: division by zero").WithField("money", m.String())
+	}
+	// Keep extra digits so the rounding mode decides the last place.
+	quotient := m.amount.DivRound(divisor, m.DecimalPlaces()+comutils.DecimalDividePrecision)
+	return NewMoney(m.currency, quotient, mode), nil
+}
+
+// Allocate splits the money by ratios without losing any smallest unit,
+// the remainder is distributed one unit at a time from the first share.
+// It fails if the amount isn't a multiple of the smallest unit (the currency meta changed after the money was built).
+func (m Money) Allocate(ratios ...decimal.Decimal) ([]Money, error) {
+	if err := checkMoneyDecimalPlaces(m.currency, m.amount); err != nil {
+		return nil, err
+	}
+	total := decimal.Zero
+	for _, ratio := range ratios {
+		if ratio.IsNegative() {
+			return nil, erroy.New("money: negative ratio").WithField("ratio", ratio)
+		}
+		total = total.Add(ratio)
+	}
+	if total.IsZero() {
+		return nil, erroy.New("money: ratios sum to zero")
+	}
+
+	var (
+		places    = m.DecimalPlaces()
+		unit      = decimal.New(1, -places)
+		remainder = m.amount.Abs()
+		shares    = make([]Money, len(ratios))
+	)
+	for i, ratio := range ratios {
+		share := m.amount.Abs().Mul(ratio).DivRound(total, places+comutils.DecimalDividePrecision).RoundFloor(places)
+		shares[i] = Money{currency: m.currency, amount: share}
+		remainder = remainder.Sub(share)
+	}
+	for i := 0; remainder.IsPositive(); i = (i + 1) % len(shares) {
+		if ratios[i].IsZero() {
+			continue
+		}
+		shares[i].amount = shares[i].amount.Add(unit)
+		remainder = remainder.Sub(unit)
+	}
+	if m.amount.IsNegative() {
+		for i := range shares {
+			shares[i].amount = shares[i].amount.Neg()
+		}
+	}
+	return shares, nil
+}
+
+type tMoneyJson struct {
+	Currency Currency        `json:"currency"`
+	Value    decimal.Decimal `json:"value"`
+}
+
+// MarshalJSON keeps trailing zeros of the decimal places like String.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Currency Currency `json:"currency"`
+		Value    string   `json:"value"`
+	}{
+		Currency: m.currency,
+		Value:    m.amount.StringFixed(m.DecimalPlaces()),
+	})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var moneyJson tMoneyJson
+	if err := json.Unmarshal(data, &moneyJson); err != nil {
+		return erroy.WrapMessage(err, "money: decode json")
+	}
+	money, err := NewMoneyExact(moneyJson.Currency, moneyJson.Value)
+	if err != nil {
+		return err
+	}
+	*m = money
+	return nil
+}
+
+func (m Money) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Money) UnmarshalText(text []byte) (err error) {
+	*m, err = ParseMoney(string(text))
+	return
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func (m *Money) Scan(input any) error {
+	switch inputT := input.(type) {
+	case string:
+		return m.UnmarshalText([]byte(inputT))
+	case []byte:
+		return m.UnmarshalText(inputT)
+	default:
+		return erroy.New("money: unsupported scan type %T", input)
+	}
+}
+
+// GormDataType stores the money in a single text column.
+func (Money) GormDataType() string {
+	return "string"
+}
@@ -0,0 +1,214 @@
+package gmeta
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func withTestMoneyCurrencies(t *testing.T) {
+	t.Helper()
+	registry := newTestRegistry()
+	registry.RegisterCurrency(CurrencyMeta{Code: "JPY", DecimalPlaces: 0, Kind: CurrencyKindFiat})
+	getter := vMoneyCurrencyMetaGetter
+	SetMoneyCurrencyMetaGetter(registry.Currency)
+	t.Cleanup(func() { SetMoneyCurrencyMetaGetter(getter) })
+}
+
+func TestParseMoney(t *testing.T) {
+	withTestMoneyCurrencies(t)
+	tests := []struct {
+		text    string
+		money   string
+		wantErr bool
+	}{
+		{"1.25 USD", "1.25 USD", false},
+		{" 1.5 USD ", "1.50 USD", false},
+		{"1.000 USD", "1.00 USD", false},
+		{"-3 JPY", "-3 JPY", false},
+		{"0.00000001 BTC", "0.00000001 BTC", false},
+		{"1.005 USD", "", true},
+		{"1.5 JPY", "", true},
+		{"0.000000001 BTC", "", true},
+		{"1.25", "", true},
+		{"abc USD", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			money, err := ParseMoney(tt.text)
+			if (err != nil) != tt.wantErr || (err == nil && money.String() != tt.money) {
+				t.Fatalf("ParseMoney(%q) = %s, %v, want %s, wantErr %v", tt.text, money, err, tt.money, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewMoneyRounding(t *testing.T) {
+	withTestMoneyCurrencies(t)
+	amount := decimal.RequireFromString("1.005")
+	tests := []struct {
+		mode  RoundingMode
+		money string
+	}{
+		{RoundingHalfEven, "1.00 USD"},
+		{RoundingHalfUp, "1.01 USD"},
+		{RoundingFloor, "1.00 USD"},
+		{RoundingCeil, "1.01 USD"},
+	}
+	for _, tt := range tests {
+		if money := NewMoney("USD", amount, tt.mode); money.String() != tt.money {
+			t.Fatalf("NewMoney(%s, %d) = %s, want %s", amount, tt.mode, money, tt.money)
+		}
+	}
+	if _, err := NewMoneyExact("USD", amount); err == nil {
+		t.Fatal("NewMoneyExact must fail on extra decimal places")
+	}
+}
+
+func TestMoneyJson(t *testing.T) {
+	withTestMoneyCurrencies(t)
+	money := NewMoney("USD", decimal.RequireFromString("1.5"), RoundingHalfEven)
+	data, err := json.Marshal(money)
+	if err != nil || string(data) != `{"currency":"USD","value":"1.50"}` {
+		t.Fatalf("Marshal = %s, %v", data, err)
+	}
+	var decoded Money
+	if err := json.Unmarshal(data, &decoded); err != nil || !decoded.Equal(money) {
+		t.Fatalf("Unmarshal = %s, %v, want %s", decoded, err, money)
+	}
+	for _, input := range []string{`{"currency":"USD","value":"1.005"}`, `{"currency":"USD","value":1.005}`, `[]`} {
+		if err := json.Unmarshal([]byte(input), &decoded); err == nil {
+			t.Fatalf("Unmarshal(%s) must fail", input)
+		}
+	}
+}
+
+func TestMoneySql(t *testing.T) {
+	withTestMoneyCurrencies(t)
+	money := NewMoney("BTC", decimal.RequireFromString("0.1"), RoundingHalfEven)
+	value, err := money.Value()
+	if err != nil || value != "0.10000000 BTC" {
+		t.Fatalf("Value = %v, %v", value, err)
+	}
+	for _, input := range []any{value, []byte(value.(string))} {
+		var scanned Money
+		if err := scanned.Scan(input); err != nil || !scanned.Equal(money) {
+			t.Fatalf("Scan(%v) = %s, %v, want %s", input, scanned, err, money)
+		}
+	}
+	var scanned Money
+	for _, input := range []any{"0.000000001 BTC", 1, nil} {
+		if err := scanned.Scan(input); err == nil {
+			t.Fatalf("Scan(%v) must fail", input)
+		}
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	withTestMoneyCurrencies(t)
+	var (
+		usd1 = NewMoney("USD", decimal.RequireFromString("1.25"), RoundingHalfEven)
+		usd2 = NewMoney("USD", decimal.RequireFromString("0.75"), RoundingHalfEven)
+		jpy  = NewMoney("JPY", decimal.NewFromInt(100), RoundingHalfEven)
+	)
+	if sum, err := usd1.Add(usd2); err != nil || sum.String() != "2.00 USD" {
+		t.Fatalf("Add = %s, %v", sum, err)
+	}
+	if diff, err := usd2.Sub(usd1); err != nil || diff.String() != "-0.50 USD" {
+		t.Fatalf("Sub = %s, %v", diff, err)
+	}
+	if cmp, err := usd1.Cmp(usd2); err != nil || cmp != 1 {
+		t.Fatalf("Cmp = %d, %v", cmp, err)
+	}
+	if product := usd1.Mul(decimal.RequireFromString("0.5"), RoundingHalfUp); product.String() != "0.63 USD" {
+		t.Fatalf("Mul = %s", product)
+	}
+	if quotient, err := usd2.Div(decimal.NewFromInt(3), RoundingHalfEven); err != nil || quotient.String() != "0.25 USD" {
+		t.Fatalf("Div = %s, %v", quotient, err)
+	}
+	if _, err := usd1.Div(decimal.Zero, RoundingHalfEven); err == nil {
+		t.Fatal("Div by zero must fail")
+	}
+
+	_, addErr := usd1.Add(jpy)
+	_, subErr := usd1.Sub(jpy)
+	_, cmpErr := usd1.Cmp(jpy)
+	for _, err := range []error{addErr, subErr, cmpErr} {
+		ourErr, ok := FindOurError(err)
+		if !ok || ourErr.Code() != ErrorCodeCurrency {
+			t.Fatalf("currency mismatch error = %v", err)
+		}
+	}
+	if usd1.Equal(jpy) {
+		t.Fatal("money of different currencies must not be equal")
+	}
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	withTestMoneyCurrencies(t)
+	ratios := func(values ...int64) []decimal.Decimal {
+		result := make([]decimal.Decimal, len(values))
+		for i, value := range values {
+			result[i] = decimal.NewFromInt(value)
+		}
+		return result
+	}
+	tests := []struct {
+		money  string
+		ratios []decimal.Decimal
+		shares []string
+	}{
+		{"100.00 USD", ratios(1, 1, 1), []string{"33.34 USD", "33.33 USD", "33.33 USD"}},
+		{"-100.00 USD", ratios(1, 1, 1), []string{"-33.34 USD", "-33.33 USD", "-33.33 USD"}},
+		{"0.05 USD", ratios(3, 7), []string{"0.02 USD", "0.03 USD"}},
+		{"10 JPY", ratios(0, 1, 2), []string{"0 JPY", "4 JPY", "6 JPY"}},
+		{"0.00000001 BTC", ratios(1, 1), []string{"0.00000001 BTC", "0.00000000 BTC"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.money, func(t *testing.T) {
+			money, err := ParseMoney(tt.money)
+			if err != nil {
+				t.Fatal(err)
+			}
+			shares, err := money.Allocate(tt.ratios...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sum := ZeroMoney(money.Currency())
+			for i, share := range shares {
+				if share.String() != tt.shares[i] {
+					t.Fatalf("share %d = %s, want %s", i, share, tt.shares[i])
+				}
+				if sum, err = sum.Add(share); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !sum.Equal(money) {
+				t.Fatalf("shares sum to %s, want %s", sum, money)
+			}
+		})
+	}
+
+	usd := NewMoney("USD", decimal.NewFromInt(1), RoundingHalfEven)
+	for _, invalidRatios := range [][]decimal.Decimal{ratios(0, 0), ratios(1, -1), nil} {
+		if _, err := usd.Allocate(invalidRatios...); err == nil {
+			t.Fatalf("Allocate(%v) must fail", invalidRatios)
+		}
+	}
+	// The money is built before USD has 2 decimal places.
+	unscaled := Money{currency: "USD", amount: decimal.RequireFromString("1.005")}
+	if _, err := unscaled.Allocate(ratios(1, 1)...); err == nil {
+		t.Fatal("Allocate of an amount off the currency scale must fail")
+	}
+}
+
+func TestMoneyDefaultDecimalPlaces(t *testing.T) {
+	money, err := NewMoneyExact("UNKNOWN", decimal.RequireFromString("0.000000000000000001"))
+	if err != nil || money.DecimalPlaces() != MoneyDefaultDecimalPlaces {
+		t.Fatalf("NewMoneyExact = %s, %v", money, err)
+	}
+	if _, err := NewMoneyExact("UNKNOWN", decimal.RequireFromString("0.0000000000000000001")); err == nil {
+		t.Fatal("NewMoneyExact must fail beyond the default decimal places")
+	}
+}
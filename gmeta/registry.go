@@ -0,0 +1,319 @@
+package gmeta
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+type CurrencyKind string
+
+const (
+	CurrencyKindFiat       CurrencyKind = "fiat"
+	CurrencyKindCrypto     CurrencyKind = "crypto"
+	CurrencyKindStablecoin CurrencyKind = "stablecoin"
+)
+
+type NetworkMeta struct {
+	Code           BlockchainNetwork `json:"code" yaml:"code"`
+	Type           BlockchainType    `json:"type" yaml:"type"`
+	NativeCurrency Currency          `json:"native_currency" yaml:"native_currency"`
+	// ChainID is only set for EVM networks.
+	ChainID uint64 `json:"chain_id,omitempty" yaml:"chain_id,omitempty"`
+	// Mainnet is set for testnets only.
+	Mainnet       BlockchainNetwork `json:"mainnet,omitempty" yaml:"mainnet,omitempty"`
+	Confirmations uint32            `json:"confirmations" yaml:"confirmations"`
+	// Explorer templates contain `{hash}` and `{address}` placeholders.
+	ExplorerTxURL      string `json:"explorer_tx_url,omitempty" yaml:"explorer_tx_url,omitempty"`
+	ExplorerAddressURL string `json:"explorer_address_url,omitempty" yaml:"explorer_address_url,omitempty"`
+}
+
+func (m NetworkMeta) IsTestnet() bool {
+	return m.Mainnet != ""
+}
+
+// TxURL is empty if the network has no explorer.
+func (m NetworkMeta) TxURL(hash string) string {
+	return strings.ReplaceAll(m.ExplorerTxURL, "{hash}", hash)
+}
+
+func (m NetworkMeta) AddressURL(address string) string {
+	return strings.ReplaceAll(m.ExplorerAddressURL, "{address}", address)
+}
+
+type RegistryData struct {
+	Currencies []CurrencyMeta        `json:"currencies" yaml:"currencies"`
+	Networks   []NetworkMeta         `json:"networks" yaml:"networks"`
+	Tokens     []TokenMeta           `json:"tokens" yaml:"tokens"`
+	Aliases    map[Currency]Currency `json:"aliases" yaml:"aliases"`
+}
+
+// DefaultRegistry is seeded by gconsts, it's used by methods of BlockchainNetwork and BlockchainType.
+var DefaultRegistry = NewRegistry()
+
+// Registry holds currencies, networks and identical currency aliases, it's safe for concurrent use.
+type Registry struct {
+	mux        sync.RWMutex
+	currencies map[Currency]CurrencyMeta
+	networks   map[BlockchainNetwork]NetworkMeta
+	aliases    map[Currency]Currency
+	tokens     map[NetworkCurrency]TokenMeta
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		currencies: make(map[Currency]CurrencyMeta),
+		networks:   make(map[BlockchainNetwork]NetworkMeta),
+		aliases:    make(map[Currency]Currency),
+		tokens:     make(map[NetworkCurrency]TokenMeta),
+	}
+}
+
+func (r *Registry) RegisterCurrency(metas ...CurrencyMeta) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	for _, meta := range metas {
+		r.currencies[meta.Code] = meta
+	}
+}
+
+func (r *Registry) RegisterNetwork(metas ...NetworkMeta) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	for _, meta := range metas {
+		r.networks[meta.Code] = meta
+	}
+}
+
+func (r *Registry) RegisterToken(metas ...TokenMeta) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	for _, meta := range metas {
+		r.tokens[meta.NetworkCurrency()] = meta
+	}
+}
+
+// RegisterAlias makes `currency` share the meta of `identicalTo`.
+func (r *Registry) RegisterAlias(currency Currency, identicalTo Currency) {
+	if currency == identicalTo {
+		return
+	}
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.aliases[currency] = identicalTo
+}
+
+// Currency resolves aliases, the returned meta keeps the requested code.
+func (r *Registry) Currency(currency Currency) (_ CurrencyMeta, exists bool) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	if meta, ok := r.currencies[currency]; ok {
+		return meta, true
+	}
+	if identicalCurrency, ok := r.aliases[currency]; ok {
+		if meta, ok := r.currencies[identicalCurrency]; ok {
+			meta.Code = currency
+			return meta, true
+		}
+	}
+	return
+}
+
+func (r *Registry) IsKnownCurrency(currency Currency) bool {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	if _, ok := r.currencies[currency]; ok {
+		return true
+	}
+	_, ok := r.aliases[currency]
+	return ok
+}
+
+// HasCurrencies reports whether any currency is registered.
+func (r *Registry) HasCurrencies() bool {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	return len(r.currencies) > 0 || len(r.aliases) > 0
+}
+
+// ResolveAlias returns the currency itself if it isn't an alias.
+func (r *Registry) ResolveAlias(currency Currency) Currency {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	if identicalCurrency, ok := r.aliases[currency]; ok {
+		return identicalCurrency
+	}
+	return currency
+}
+
+func (r *Registry) Network(network BlockchainNetwork) (_ NetworkMeta, exists bool) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	meta, exists := r.networks[network]
+	return meta, exists
+}
+
+func (r *Registry) Token(coin NetworkCurrency) (_ TokenMeta, exists bool) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	meta, exists := r.tokens[coin]
+	return meta, exists
+}
+
+// Tokens returns metas ordered by network then currency.
+func (r *Registry) Tokens() []TokenMeta {
+	r.mux.RLock()
+	metas := make([]TokenMeta, 0, len(r.tokens))
+	for _, meta := range r.tokens {
+		metas = append(metas, meta)
+	}
+	r.mux.RUnlock()
+	sort.Slice(metas, func(i, j int) bool {
+		if metas[i].Network != metas[j].Network {
+			return metas[i].Network < metas[j].Network
+		}
+		return metas[i].Currency < metas[j].Currency
+	})
+	return metas
+}
+
+// DecimalPlaces prefers the token decimals on the network to the currency ones.
+func (r *Registry) DecimalPlaces(coin NetworkCurrency) (uint8, bool) {
+	if tokenMeta, ok := r.Token(coin); ok {
+		return tokenMeta.DecimalPlaces, true
+	}
+	if currencyMeta, ok := r.Currency(coin.Currency); ok {
+		return currencyMeta.DecimalPlaces, true
+	}
+	return 0, false
+}
+
+// NetworksOf returns networks of the type ordered by code.
+func (r *Registry) NetworksOf(chainType BlockchainType) []NetworkMeta {
+	var metas []NetworkMeta
+	for _, meta := range r.Networks() {
+		if meta.Type == chainType {
+			metas = append(metas, meta)
+		}
+	}
+	return metas
+}
+
+// Testnets returns testnets paired with the mainnet ordered by code.
+func (r *Registry) Testnets(mainnet BlockchainNetwork) []NetworkMeta {
+	var metas []NetworkMeta
+	for _, meta := range r.Networks() {
+		if meta.Mainnet == mainnet {
+			metas = append(metas, meta)
+		}
+	}
+	return metas
+}
+
+// HasNetworks reports whether any network is registered.
+func (r *Registry) HasNetworks() bool {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	return len(r.networks) > 0
+}
+
+func (r *Registry) IsKnownNetwork(network BlockchainNetwork) bool {
+	_, ok := r.Network(network)
+	return ok
+}
+
+// Currencies returns metas ordered by code.
+func (r *Registry) Currencies() []CurrencyMeta {
+	r.mux.RLock()
+	metas := make([]CurrencyMeta, 0, len(r.currencies))
+	for _, meta := range r.currencies {
+		metas = append(metas, meta)
+	}
+	r.mux.RUnlock()
+	sort.Slice(metas, func(i, j int) bool {
+		return metas[i].Code < metas[j].Code
+	})
+	return metas
+}
+
+// Networks returns metas ordered by code.
+func (r *Registry) Networks() []NetworkMeta {
+	r.mux.RLock()
+	metas := make([]NetworkMeta, 0, len(r.networks))
+	for _, meta := range r.networks {
+		metas = append(metas, meta)
+	}
+	r.mux.RUnlock()
+	sort.Slice(metas, func(i, j int) bool {
+		return metas[i].Code < metas[j].Code
+	})
+	return metas
+}
+
+func (r *Registry) Snapshot() RegistryData {
+	data := RegistryData{
+		Currencies: r.Currencies(),
+		Networks:   r.Networks(),
+		Tokens:     r.Tokens(),
+	}
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	data.Aliases = make(map[Currency]Currency, len(r.aliases))
+	for currency, identicalTo := range r.aliases {
+		data.Aliases[currency] = identicalTo
+	}
+	return data
+}
+
+// Load merges the data, existing entries of the same codes are replaced.
+func (r *Registry) Load(data RegistryData) {
+	r.RegisterCurrency(data.Currencies...)
+	r.RegisterNetwork(data.Networks...)
+	r.RegisterToken(data.Tokens...)
+	for currency, identicalTo := range data.Aliases {
+		r.RegisterAlias(currency, identicalTo)
+	}
+}
+
+func (r *Registry) LoadJSON(content []byte) error {
+	var data RegistryData
+	if err := json.Unmarshal(content, &data); err != nil {
+		return erroy.WrapStack(err, "registry: decode json")
+	}
+	r.Load(data)
+	return nil
+}
+
+func (r *Registry) LoadYAML(content []byte) error {
+	var data RegistryData
+	if err := yaml.Unmarshal(content, &data); err != nil {
+		return erroy.WrapStack(err, "registry: decode yaml")
+	}
+	r.Load(data)
+	return nil
+}
+
+// LoadFile decodes the file by its extension, `.yaml` and `.yml` are YAML and others are JSON.
+func (r *Registry) LoadFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return erroy.WrapStack(err, "registry: read file").WithField("path", path)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = r.LoadYAML(content)
+	default:
+		err = r.LoadJSON(content)
+	}
+	if err != nil {
+		return erroy.Wrap(err).WithField("path", path)
+	}
+	return nil
+}
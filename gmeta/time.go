@@ -3,7 +3,7 @@ package gmeta
 import (
 	"time"
 
-	comutils "gitea.alchemymagic.app/snap/go-common/utils"
+	comutils "github.com/kiyuu10/common-lib-go/utils"
 )
 
 type UnixTime int64
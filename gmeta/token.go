@@ -0,0 +1,27 @@
+package gmeta
+
+type TokenStandard string
+
+const (
+	TokenStandardERC20  TokenStandard = "ERC20"
+	TokenStandardTRC20  TokenStandard = "TRC20"
+	TokenStandardSPL    TokenStandard = "SPL"
+	TokenStandardJetton TokenStandard = "Jetton"
+)
+
+// TokenMeta describes a currency issued by a contract on a network,
+// its decimal places may differ from network to network.
+type TokenMeta struct {
+	Currency        Currency          `json:"currency" yaml:"currency"`
+	Network         BlockchainNetwork `json:"network" yaml:"network"`
+	ContractAddress string            `json:"contract_address" yaml:"contract_address"`
+	Standard        TokenStandard     `json:"standard" yaml:"standard"`
+	DecimalPlaces   uint8             `json:"decimal_places" yaml:"decimal_places"`
+}
+
+func (m TokenMeta) NetworkCurrency() NetworkCurrency {
+	return NetworkCurrency{
+		Network:  m.Network,
+		Currency: m.Currency,
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"strconv"
 	"strings"
 
-	comutils "gitea.alchemymagic.app/snap/go-common/utils"
+	comutils "github.com/kiyuu10/common-lib-go/utils"
 )
 
 type UID uint64
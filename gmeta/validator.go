@@ -0,0 +1,163 @@
+package gmeta
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+
+	"github.com/kiyuu10/common-lib-go/types"
+)
+
+// Validator tags used by gmeta structs, they're registered here so validating the structs doesn't depend on gconsts.
+// `currency` and `network` accept entries of DefaultRegistry (seeded by importing gconsts),
+// they accept any value while DefaultRegistry has no currency or network respectively.
+const (
+	ValidatorTagCurrency      = "currency"
+	ValidatorTagNetwork       = "network"
+	ValidatorTagDecimalGt     = "decimal_gt"
+	ValidatorTagDecimalGte    = "decimal_gte"
+	ValidatorTagDecimalLt     = "decimal_lt"
+	ValidatorTagDecimalLte    = "decimal_lte"
+	ValidatorTagDecimalPlaces = "decimal_places"
+	ValidatorTagAmountMarkup  = "amount_markup"
+	ValidatorTagUnixTimeRange = "unix_time_range"
+
+	// ValidatorUnixTimeMax is 3000-01-01, a larger value is likely in milliseconds.
+	ValidatorUnixTimeMax = 32503680000
+)
+
+func init() {
+	types.ValidatorRegisterValidation(ValidatorTagCurrency, validateCurrency)
+	types.ValidatorRegisterValidation(ValidatorTagNetwork, validateNetwork)
+	types.ValidatorRegisterValidation(ValidatorTagDecimalGt, validateDecimalCmp(func(cmp int) bool { return cmp > 0 }))
+	types.ValidatorRegisterValidation(ValidatorTagDecimalGte, validateDecimalCmp(func(cmp int) bool { return cmp >= 0 }))
+	types.ValidatorRegisterValidation(ValidatorTagDecimalLt, validateDecimalCmp(func(cmp int) bool { return cmp < 0 }))
+	types.ValidatorRegisterValidation(ValidatorTagDecimalLte, validateDecimalCmp(func(cmp int) bool { return cmp <= 0 }))
+	types.ValidatorRegisterValidation(ValidatorTagDecimalPlaces, validateDecimalPlaces)
+	types.ValidatorRegisterValidation(ValidatorTagAmountMarkup, validateAmountMarkup)
+	types.ValidatorRegisterValidation(ValidatorTagUnixTimeRange, validateUnixTimeRange)
+	types.ValidatorRegisterStructValidation(validateTimeRange, TimeRange{})
+
+	types.ValidatorRegisterDefaultMessage(ValidatorTagCurrency, "{{.field}} must be a supported currency")
+	types.ValidatorRegisterDefaultMessage(ValidatorTagNetwork, "{{.field}} must be a supported network")
+	types.ValidatorRegisterDefaultMessage(ValidatorTagDecimalGt, "{{.field}} must be greater than {{.param}}")
+	types.ValidatorRegisterDefaultMessage(ValidatorTagDecimalGte, "{{.field}} must be greater than or equal to {{.param}}")
+	types.ValidatorRegisterDefaultMessage(ValidatorTagDecimalLt, "{{.field}} must be less than {{.param}}")
+	types.ValidatorRegisterDefaultMessage(ValidatorTagDecimalLte, "{{.field}} must be less than or equal to {{.param}}")
+	types.ValidatorRegisterDefaultMessage(ValidatorTagDecimalPlaces, "{{.field}} has too many decimal places")
+	types.ValidatorRegisterDefaultMessage(ValidatorTagAmountMarkup, "{{.field}} must be an amount or a percentage")
+	types.ValidatorRegisterDefaultMessage(ValidatorTagUnixTimeRange, "{{.field}} must be a valid time range")
+}
+
+func validateCurrency(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+	return !DefaultRegistry.HasCurrencies() || DefaultRegistry.IsKnownCurrency(Currency(field.String()))
+}
+
+func validateNetwork(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+	return !DefaultRegistry.HasNetworks() || DefaultRegistry.IsKnownNetwork(BlockchainNetwork(field.String()))
+}
+
+// validatorFieldDecimal reads decimal.Decimal from the parent struct because
+// the registered custom type converts it to int64/float64 and loses precision.
+func validatorFieldDecimal(fl validator.FieldLevel) (decimal.Decimal, bool) {
+	if parent := fl.Parent(); parent.Kind() == reflect.Struct {
+		if rawField := parent.FieldByName(fl.StructFieldName()); rawField.IsValid() && rawField.CanInterface() {
+			if value, ok := rawField.Interface().(decimal.Decimal); ok {
+				return value, true
+			}
+		}
+	}
+	field := fl.Field()
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return decimal.NewFromInt(field.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return decimal.NewFromUint64(field.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return decimal.NewFromFloat(field.Float()), true
+	case reflect.String:
+		value, err := decimal.NewFromString(field.String())
+		return value, err == nil
+	default:
+		return decimal.Zero, false
+	}
+}
+
+func validateDecimalCmp(accept func(cmp int) bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		value, ok := validatorFieldDecimal(fl)
+		if !ok {
+			return false
+		}
+		threshold, err := decimal.NewFromString(fl.Param())
+		if err != nil {
+			return false
+		}
+		return accept(value.Cmp(threshold))
+	}
+}
+
+// validateDecimalPlaces is used as `decimal_places=Currency` (a struct field name) or `decimal_places=8`.
+// Currencies without meta are not limited.
+func validateDecimalPlaces(fl validator.FieldLevel) bool {
+	value, ok := validatorFieldDecimal(fl)
+	if !ok {
+		return false
+	}
+	var maxPlaces int
+	if places, err := strconv.Atoi(fl.Param()); err == nil {
+		maxPlaces = places
+	} else {
+		currencyField, currencyKind, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param())
+		if !ok || currencyKind != reflect.String {
+			return false
+		}
+		currencyMeta, ok := DefaultRegistry.Currency(Currency(currencyField.String()))
+		if !ok {
+			return true
+		}
+		maxPlaces = int(currencyMeta.DecimalPlaces)
+	}
+	return value.Equal(value.Truncate(int32(maxPlaces)))
+}
+
+func validateAmountMarkup(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+	_, err := NewAmountModifier(field.String())
+	return err == nil
+}
+
+// validateUnixTimeRange checks the value is unix seconds, not milliseconds or negative.
+func validateUnixTimeRange(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	var value int64
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value = field.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value = int64(field.Uint())
+	default:
+		return false
+	}
+	return value >= 0 && value <= ValidatorUnixTimeMax
+}
+
+func validateTimeRange(sl validator.StructLevel) {
+	timeRange := sl.Current().Interface().(TimeRange)
+	if timeRange.FromTime > timeRange.ToTime {
+		sl.ReportError(timeRange.ToTime, "to_time", "ToTime", ValidatorTagUnixTimeRange, "from_time")
+	}
+}
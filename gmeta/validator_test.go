@@ -0,0 +1,113 @@
+package gmeta
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/kiyuu10/common-lib-go/types"
+)
+
+func withTestRegistry(t *testing.T, registry *Registry) {
+	t.Helper()
+	defaultRegistry := DefaultRegistry
+	DefaultRegistry = registry
+	t.Cleanup(func() { DefaultRegistry = defaultRegistry })
+}
+
+func newTestRegistry() *Registry {
+	registry := NewRegistry()
+	registry.RegisterCurrency(
+		CurrencyMeta{Code: "USD", DecimalPlaces: 2, Kind: CurrencyKindFiat},
+		CurrencyMeta{Code: "BTC", DecimalPlaces: 8, Kind: CurrencyKindCrypto},
+	)
+	registry.RegisterAlias("XBT", "BTC")
+	registry.RegisterNetwork(NetworkMeta{Code: "BTC"})
+	return registry
+}
+
+func TestValidatorTags(t *testing.T) {
+	type tDecimalHolder struct {
+		Gt     decimal.Decimal `validate:"decimal_gt=0"`
+		Gte    decimal.Decimal `validate:"decimal_gte=0"`
+		Lt     decimal.Decimal `validate:"decimal_lt=10"`
+		Lte    decimal.Decimal `validate:"decimal_lte=10"`
+		Places decimal.Decimal `validate:"decimal_places=2"`
+	}
+	type tMarkupHolder struct {
+		Markup string `validate:"amount_markup"`
+	}
+	type tTimeHolder struct {
+		Time int64 `validate:"unix_time_range"`
+	}
+	validDecimals := tDecimalHolder{
+		Gt:     decimal.RequireFromString("0.1"),
+		Gte:    decimal.Zero,
+		Lt:     decimal.RequireFromString("9.99"),
+		Lte:    decimal.RequireFromString("10"),
+		Places: decimal.RequireFromString("1.25"),
+	}
+	withDecimals := func(update func(holder *tDecimalHolder)) tDecimalHolder {
+		holder := validDecimals
+		update(&holder)
+		return holder
+	}
+	tests := []struct {
+		name  string
+		value any
+		valid bool
+	}{
+		{"currency", NetworkCurrency{Network: "BTC", Currency: "BTC"}, true},
+		{"currency alias", NetworkCurrency{Network: "BTC", Currency: "XBT"}, true},
+		{"unknown currency", NetworkCurrency{Network: "BTC", Currency: "DOGE"}, false},
+		{"unknown network", NetworkCurrency{Network: "DOGE", Currency: "BTC"}, false},
+		{"decimals", validDecimals, true},
+		{"decimal_gt", withDecimals(func(holder *tDecimalHolder) { holder.Gt = decimal.Zero }), false},
+		{"decimal_gte", withDecimals(func(holder *tDecimalHolder) { holder.Gte = decimal.RequireFromString("-0.1") }), false},
+		{"decimal_lt", withDecimals(func(holder *tDecimalHolder) { holder.Lt = decimal.RequireFromString("10") }), false},
+		{"decimal_lte", withDecimals(func(holder *tDecimalHolder) { holder.Lte = decimal.RequireFromString("10.01") }), false},
+		{"decimal_places", withDecimals(func(holder *tDecimalHolder) { holder.Places = decimal.RequireFromString("1.255") }), false},
+		{"decimal_places of currency", NetworkCurrencyAmount{Network: "BTC", Currency: "BTC", Value: decimal.RequireFromString("0.00000001")}, true},
+		{"too many decimal_places of currency", NetworkCurrencyAmount{Network: "BTC", Currency: "BTC", Value: decimal.RequireFromString("0.000000001")}, false},
+		{"decimal_places of unknown currency", NetworkCurrencyAmount{Network: "BTC", Currency: "DOGE", Value: decimal.RequireFromString("1")}, false},
+		{"amount_markup amount", tMarkupHolder{Markup: "1.5"}, true},
+		{"amount_markup percentage", tMarkupHolder{Markup: "2%"}, true},
+		{"invalid amount_markup", tMarkupHolder{Markup: "abc"}, false},
+		{"unix_time_range", tTimeHolder{Time: 1700000000}, true},
+		{"unix_time_range in milliseconds", tTimeHolder{Time: 1700000000000}, false},
+		{"negative unix_time_range", tTimeHolder{Time: -1}, false},
+		{"time range", TimeRange{FromTime: 1700000000, ToTime: 1700000001}, true},
+		{"reversed time range", TimeRange{FromTime: 1700000001, ToTime: 1700000000}, false},
+	}
+	withTestRegistry(t, newTestRegistry())
+	validator := types.NewValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validator.Struct(tt.value); (err == nil) != tt.valid {
+				t.Fatalf("Struct(%+v) error = %v, want valid %v", tt.value, err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestValidatorTagsEmptyRegistry(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		valid bool
+	}{
+		{"any currency and network", NetworkCurrency{Network: "DOGE", Currency: "DOGE"}, true},
+		{"required currency", NetworkCurrency{Network: "DOGE"}, false},
+		{"decimal_places of unknown currency", NetworkCurrencyAmount{Network: "DOGE", Currency: "DOGE", Value: decimal.RequireFromString("0.123456789")}, true},
+		{"decimal_gt", NetworkCurrencyAmount{Network: "DOGE", Currency: "DOGE", Value: decimal.RequireFromString("-1")}, false},
+	}
+	withTestRegistry(t, NewRegistry())
+	validator := types.NewValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validator.Struct(tt.value); (err == nil) != tt.valid {
+				t.Fatalf("Struct(%+v) error = %v, want valid %v", tt.value, err, tt.valid)
+			}
+		})
+	}
+}
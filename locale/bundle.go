@@ -0,0 +1,407 @@
+package locale
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+	"text/template/parse"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+type tMessage struct {
+	forms map[PluralForm]*template.Template
+	// dataPaths are the data fields referenced by the forms, such as [user name] of `{{.user.name}}`.
+	dataPaths [][]string
+}
+
+func newMessage(forms map[PluralForm]*template.Template) tMessage {
+	paths := make(map[string][]string)
+	for _, tmpl := range forms {
+		collectTemplateDataPaths(tmpl.Tree.Root, []string{}, paths)
+	}
+	message := tMessage{forms: forms}
+	for _, path := range paths {
+		message.dataPaths = append(message.dataPaths, path)
+	}
+	return message
+}
+
+// fillData renders missing or nil fields as empty instead of the `<no value>` placeholder of text/template,
+// nested fields are filled only in existing maps so `{{if .user}}` guards keep working.
+// The given data is never modified.
+func (m tMessage) fillData(data map[string]any) map[string]any {
+	for _, path := range m.dataPaths {
+		data, _ = fillDataPath(data, path)
+	}
+	return data
+}
+
+// fillDataPath copies the maps on the path only if the field is filled,
+// missing parents and values other than maps (structs...) are left to the template.
+func fillDataPath(data map[string]any, path []string) (map[string]any, bool) {
+	value := data[path[0]]
+	if len(path) == 1 {
+		if value != nil {
+			return data, false
+		}
+		return cloneDataWith(data, path[0], ""), true
+	}
+	nested, isMap := value.(map[string]any)
+	if !isMap {
+		return data, false
+	}
+	nested, filled := fillDataPath(nested, path[1:])
+	if !filled {
+		return data, false
+	}
+	return cloneDataWith(data, path[0], nested), true
+}
+
+func cloneDataWith(data map[string]any, key string, value any) map[string]any {
+	cloned := make(map[string]any, len(data)+1)
+	maps.Copy(cloned, data)
+	cloned[key] = value
+	return cloned
+}
+
+// collectTemplateDataPaths collects fields relative to the data, `dot` is the path of `.` in the node
+// or nil if `.` isn't a data field (inside `range` or `with` of a non-field pipeline).
+func collectTemplateDataPaths(node parse.Node, dot []string, paths map[string][]string) {
+	addPath := func(prefix []string, idents []string) {
+		if prefix == nil || len(idents) == 0 {
+			return
+		}
+		path := append(append([]string{}, prefix...), idents...)
+		paths[strings.Join(path, ".")] = path
+	}
+	switch nodeT := node.(type) {
+	case *parse.ListNode:
+		if nodeT == nil {
+			return
+		}
+		for _, child := range nodeT.Nodes {
+			collectTemplateDataPaths(child, dot, paths)
+		}
+	case *parse.ActionNode:
+		collectTemplateDataPaths(nodeT.Pipe, dot, paths)
+	case *parse.PipeNode:
+		if nodeT == nil {
+			return
+		}
+		for _, cmd := range nodeT.Cmds {
+			collectTemplateDataPaths(cmd, dot, paths)
+		}
+	case *parse.CommandNode:
+		for _, arg := range nodeT.Args {
+			collectTemplateDataPaths(arg, dot, paths)
+		}
+	case *parse.ChainNode:
+		collectTemplateDataPaths(nodeT.Node, dot, paths)
+	case *parse.FieldNode:
+		addPath(dot, nodeT.Ident)
+	case *parse.VariableNode:
+		if nodeT.Ident[0] == "$" {
+			addPath([]string{}, nodeT.Ident[1:])
+		}
+	case *parse.IfNode:
+		collectTemplateDataPaths(nodeT.Pipe, dot, paths)
+		collectTemplateDataPaths(nodeT.List, dot, paths)
+		collectTemplateDataPaths(nodeT.ElseList, dot, paths)
+	case *parse.RangeNode:
+		collectTemplateDataPaths(nodeT.Pipe, dot, paths)
+		collectTemplateDataPaths(nodeT.List, nil, paths)
+		collectTemplateDataPaths(nodeT.ElseList, dot, paths)
+	case *parse.WithNode:
+		collectTemplateDataPaths(nodeT.Pipe, dot, paths)
+		collectTemplateDataPaths(nodeT.List, pipeFieldPath(nodeT.Pipe, dot), paths)
+		collectTemplateDataPaths(nodeT.ElseList, dot, paths)
+	}
+}
+
+// pipeFieldPath returns the path of a pipeline which is a single field such as `.user`, otherwise nil.
+func pipeFieldPath(pipe *parse.PipeNode, dot []string) []string {
+	if dot == nil || pipe == nil || len(pipe.Decl) > 0 || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return nil
+	}
+	field, ok := pipe.Cmds[0].Args[0].(*parse.FieldNode)
+	if !ok {
+		return nil
+	}
+	return append(append([]string{}, dot...), field.Ident...)
+}
+
+func (m tMessage) template(lang string, data map[string]any) *template.Template {
+	if len(m.forms) > 1 {
+		if count, ok := pluralCount(data[pluralDataKey]); ok {
+			if tmpl, ok := m.forms[selectPluralForm(lang, count)]; ok {
+				return tmpl
+			}
+		}
+	}
+	return m.forms[PluralOther]
+}
+
+// Bundle holds translations of many languages.
+// Message files are named by language (`en.json`, `vi.yaml`...) and contain nested objects,
+// nested keys are joined by "." and an object of plural forms (`one`, `other`...) is a plural message.
+type Bundle struct {
+	mux             sync.RWMutex
+	defaultLanguage string
+	fallbacks       map[string][]string
+	messages        map[string]map[string]tMessage
+}
+
+func NewBundle(defaultLanguage string) *Bundle {
+	return &Bundle{
+		defaultLanguage: NormalizeLanguage(defaultLanguage),
+		fallbacks:       make(map[string][]string),
+		messages:        make(map[string]map[string]tMessage),
+	}
+}
+
+func (b *Bundle) DefaultLanguage() string {
+	return b.defaultLanguage
+}
+
+func (b *Bundle) Languages() []string {
+	b.mux.RLock()
+	defer b.mux.RUnlock()
+	langs := make([]string, 0, len(b.messages))
+	for lang := range b.messages {
+		langs = append(langs, lang)
+	}
+	return langs
+}
+
+func (b *Bundle) Keys(lang string) []string {
+	b.mux.RLock()
+	defer b.mux.RUnlock()
+	messages := b.messages[NormalizeLanguage(lang)]
+	keys := make([]string, 0, len(messages))
+	for key := range messages {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (b *Bundle) HasKey(lang string, key string) bool {
+	b.mux.RLock()
+	defer b.mux.RUnlock()
+	_, ok := b.messages[NormalizeLanguage(lang)][key]
+	return ok
+}
+
+// SetFallback declares languages to be tried after `lang`, e.g. "zh-tw" -> "zh-hk", "zh".
+// The base language and the default language are always tried at the end.
+func (b *Bundle) SetFallback(lang string, fallbacks ...string) {
+	normalized := make([]string, len(fallbacks))
+	for i, fallback := range fallbacks {
+		normalized[i] = NormalizeLanguage(fallback)
+	}
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.fallbacks[NormalizeLanguage(lang)] = normalized
+}
+
+func (b *Bundle) AddMessages(lang string, messages map[string]any) error {
+	lang = NormalizeLanguage(lang)
+	parsed := make(map[string]tMessage, len(messages))
+	if err := b.parseMessages(parsed, "", messages); err != nil {
+		return erroy.WrapMessage(err, "locale: parse messages").WithField("lang", lang)
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	langMessages, ok := b.messages[lang]
+	if !ok {
+		langMessages = make(map[string]tMessage, len(parsed))
+		b.messages[lang] = langMessages
+	}
+	for key, message := range parsed {
+		langMessages[key] = message
+	}
+	return nil
+}
+
+func (b *Bundle) parseMessages(parsed map[string]tMessage, prefix string, messages map[string]any) error {
+	for key, value := range messages {
+		fullKey := prefix + key
+		switch v := value.(type) {
+		case string:
+			tmpl, err := b.parseTemplate(fullKey, v)
+			if err != nil {
+				return err
+			}
+			parsed[fullKey] = newMessage(map[PluralForm]*template.Template{PluralOther: tmpl})
+		case map[string]any:
+			if !isPluralMessage(v) {
+				if err := b.parseMessages(parsed, fullKey+".", v); err != nil {
+					return err
+				}
+				continue
+			}
+			forms := make(map[PluralForm]*template.Template, len(v))
+			for form, text := range v {
+				tmpl, err := b.parseTemplate(fullKey+"."+form, text.(string))
+				if err != nil {
+					return err
+				}
+				forms[vPluralForms[form]] = tmpl
+			}
+			if _, ok := forms[PluralOther]; !ok {
+				return erroy.New("locale: plural message requires `other` form").WithField("key", fullKey)
+			}
+			parsed[fullKey] = newMessage(forms)
+		default:
+			return erroy.New("locale: unsupported message type").
+				WithField("key", fullKey).
+				WithField("value", value)
+		}
+	}
+	return nil
+}
+
+func (b *Bundle) parseTemplate(name string, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, erroy.WrapMessage(err, "locale: parse template").WithField("key", name)
+	}
+	return tmpl, nil
+}
+
+func isPluralMessage(value map[string]any) bool {
+	for form, text := range value {
+		if _, ok := vPluralForms[form]; !ok {
+			return false
+		}
+		if _, ok := text.(string); !ok {
+			return false
+		}
+	}
+	return len(value) > 0
+}
+
+// LoadData decodes a JSON or YAML document by the file extension.
+func (b *Bundle) LoadData(lang string, ext string, data []byte) error {
+	var messages map[string]any
+	switch strings.ToLower(ext) {
+	case ".json":
+		if err := json.Unmarshal(data, &messages); err != nil {
+			return erroy.WrapStack(err, "locale: decode json").WithField("lang", lang)
+		}
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &messages); err != nil {
+			return erroy.WrapStack(err, "locale: decode yaml").WithField("lang", lang)
+		}
+	default:
+		return erroy.WrapMessage(ErrUnsupportedFile, "locale: load data").WithField("ext", ext)
+	}
+	return b.AddMessages(lang, messages)
+}
+
+func (b *Bundle) LoadFile(filePath string) error {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return erroy.WrapStack(err, "locale: read file").WithField("path", filePath)
+	}
+	ext := filepath.Ext(filePath)
+	return b.LoadData(strings.TrimSuffix(filepath.Base(filePath), ext), ext, data)
+}
+
+// LoadFS loads every JSON/YAML file in `dir` of the file system, it works with embed.FS.
+func (b *Bundle) LoadFS(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return erroy.WrapStack(err, "locale: read dir").WithField("dir", dir)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		var (
+			name = entry.Name()
+			ext  = path.Ext(name)
+		)
+		switch strings.ToLower(ext) {
+		case ".json", ".yaml", ".yml":
+		default:
+			continue
+		}
+		data, err := fs.ReadFile(fsys, path.Join(dir, name))
+		if err != nil {
+			return erroy.WrapStack(err, "locale: read file").WithField("name", name)
+		}
+		if err = b.LoadData(strings.TrimSuffix(name, ext), ext, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Bundle) LoadDir(dir string) error {
+	return b.LoadFS(os.DirFS(dir), ".")
+}
+
+// languageChain expands requested languages with their fallbacks, base languages and the default one.
+func (b *Bundle) languageChain(langs []string) []string {
+	var (
+		chain = make([]string, 0, len(langs)*2+1)
+		seen  = make(map[string]struct{}, cap(chain))
+		add   = func(lang string) {
+			if _, ok := seen[lang]; ok || lang == "" {
+				return
+			}
+			seen[lang] = struct{}{}
+			chain = append(chain, lang)
+		}
+	)
+	for _, lang := range langs {
+		add(lang)
+		for _, fallback := range b.fallbacks[lang] {
+			add(fallback)
+		}
+		add(baseLanguage(lang))
+	}
+	add(b.defaultLanguage)
+	return chain
+}
+
+func (b *Bundle) lookup(langs []string, key string) (string, tMessage, bool) {
+	b.mux.RLock()
+	defer b.mux.RUnlock()
+	for _, lang := range b.languageChain(langs) {
+		if message, ok := b.messages[lang][key]; ok {
+			return lang, message, true
+		}
+	}
+	return "", tMessage{}, false
+}
+
+func (b *Bundle) TranslateKey(ctx context.Context, key string) (string, error) {
+	return b.TranslateKeyData(ctx, key, nil)
+}
+
+// TranslateKeyData resolves languages from context, nil context uses the default language only.
+func (b *Bundle) TranslateKeyData(ctx context.Context, key string, data map[string]any) (string, error) {
+	lang, message, ok := b.lookup(LanguagesFromContext(ctx), key)
+	if !ok {
+		return "", erroy.WrapMessage(ErrNotFound, "locale: translate key").WithField("key", key)
+	}
+	var buf strings.Builder
+	if err := message.template(lang, data).Execute(&buf, message.fillData(data)); err != nil {
+		return "", erroy.WrapStack(err, "locale: execute template").
+			WithField("key", key).
+			WithField("lang", lang)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,150 @@
+package locale
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestTranslateKeyDataMissingData(t *testing.T) {
+	bundle := NewBundle("en")
+	err := bundle.AddMessages("en", map[string]any{
+		"literal":  "Shown as <no value> in docs",
+		"field":    "Hello {{.name}}!",
+		"branch":   "{{if .vip}}VIP {{end}}{{.name}}",
+		"variable": "{{with .user}}{{$.greeting}} {{.}}{{end}}",
+		"items": map[string]any{
+			"one":   "{{.count}} item of {{.owner}}",
+			"other": "{{.count}} items of {{.owner}}",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		key  string
+		data map[string]any
+		want string
+	}{
+		{"literal", nil, "Shown as <no value> in docs"},
+		{"field", nil, "Hello !"},
+		{"field", map[string]any{"name": nil}, "Hello !"},
+		{"field", map[string]any{"name": "An"}, "Hello An!"},
+		{"branch", map[string]any{"name": "An"}, "An"},
+		{"variable", map[string]any{"user": "An"}, " An"},
+		{"items", map[string]any{"count": 1}, "1 item of "},
+		{"items", map[string]any{"count": 2, "owner": "An"}, "2 items of An"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			data := make(map[string]any, len(tt.data))
+			for key, value := range tt.data {
+				data[key] = value
+			}
+			got, err := bundle.TranslateKeyData(nil, tt.key, data)
+			if err != nil || got != tt.want {
+				t.Errorf("TranslateKeyData(%s, %v) = %q, %v, want %q", tt.key, tt.data, got, err, tt.want)
+			}
+			if len(data) != len(tt.data) {
+				t.Errorf("data is modified: %v", data)
+			}
+		})
+	}
+}
+
+func TestTranslateKeyDataNestedData(t *testing.T) {
+	bundle := NewBundle("en")
+	err := bundle.AddMessages("en", map[string]any{
+		"path":  "Hi {{.user.name}}!",
+		"guard": "{{if .user}}Hi {{.user.name}}{{else}}Guest{{end}}",
+		"with":  "{{with .user}}Hi {{.name}}{{$.suffix}}{{end}}",
+		"range": "{{range .users}}{{.}},{{end}}{{.total}}",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		key  string
+		data map[string]any
+		want string
+	}{
+		{"path", "path", map[string]any{"user": map[string]any{}}, "Hi !"},
+		{"path nil field", "path", map[string]any{"user": map[string]any{"name": nil}}, "Hi !"},
+		{"path value", "path", map[string]any{"user": map[string]any{"name": "An"}}, "Hi An!"},
+		{"guard missing parent", "guard", nil, "Guest"},
+		{"guard", "guard", map[string]any{"user": map[string]any{"id": 1}}, "Hi "},
+		{"with missing parent", "with", nil, ""},
+		{"with", "with", map[string]any{"user": map[string]any{"id": 1}}, "Hi "},
+		{"with value", "with", map[string]any{"user": map[string]any{"name": "An"}, "suffix": "!"}, "Hi An!"},
+		{"range", "range", map[string]any{"users": []string{"An", "Binh"}}, "An,Binh,"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var user map[string]any
+			if tt.data != nil {
+				user, _ = tt.data["user"].(map[string]any)
+			}
+			userLen := len(user)
+			got, err := bundle.TranslateKeyData(nil, tt.key, tt.data)
+			if err != nil || got != tt.want {
+				t.Errorf("TranslateKeyData(%s, %v) = %q, %v, want %q", tt.key, tt.data, got, err, tt.want)
+			}
+			if len(user) != userLen {
+				t.Errorf("nested data is modified: %v", user)
+			}
+		})
+	}
+}
+
+func TestTranslateKeyNestedKeys(t *testing.T) {
+	bundle := NewBundle("en")
+	err := bundle.AddMessages("en", map[string]any{
+		"errors": map[string]any{
+			"wallet": map[string]any{
+				"not_found": "Wallet not found",
+				"locked":    "Wallet is locked",
+			},
+			"items": map[string]any{
+				"one":   "{{.count}} item",
+				"other": "{{.count}} items",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = bundle.AddMessages("vi", map[string]any{
+		"errors": map[string]any{
+			"wallet": map[string]any{"not_found": "Không tìm thấy ví"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		lang string
+		key  string
+		data map[string]any
+		want string
+	}{
+		{"en", "errors.wallet.not_found", nil, "Wallet not found"},
+		{"vi", "errors.wallet.not_found", nil, "Không tìm thấy ví"},
+		{"vi", "errors.wallet.locked", nil, "Wallet is locked"},
+		{"en", "errors.items", map[string]any{"count": 1}, "1 item"},
+		{"en", "errors.items", map[string]any{"count": 3}, "3 items"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lang+"/"+tt.key, func(t *testing.T) {
+			got, err := bundle.TranslateKeyData(WithLanguage(context.Background(), tt.lang), tt.key, tt.data)
+			if err != nil || got != tt.want {
+				t.Errorf("TranslateKeyData(%s, %s) = %q, %v, want %q", tt.lang, tt.key, got, err, tt.want)
+			}
+		})
+	}
+	for _, key := range []string{"errors", "errors.wallet", "errors.items.one"} {
+		if _, err := bundle.TranslateKey(nil, key); !errors.Is(err, ErrNotFound) {
+			t.Errorf("TranslateKey(%s) error = %v, want ErrNotFound", key, err)
+		}
+	}
+}
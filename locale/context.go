@@ -0,0 +1,84 @@
+package locale
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WithLanguage sets the explicit language which has higher priority than Accept-Language.
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, cContextKeyLanguage, NormalizeLanguage(lang))
+}
+
+// WithAcceptLanguage keeps the raw `Accept-Language` header, it's parsed on translation.
+func WithAcceptLanguage(ctx context.Context, header string) context.Context {
+	return context.WithValue(ctx, cContextKeyAcceptLanguage, header)
+}
+
+// LanguagesFromContext returns the requested languages ordered by priority.
+func LanguagesFromContext(ctx context.Context) []string {
+	if ctx == nil {
+		return nil
+	}
+	var langs []string
+	if lang, ok := ctx.Value(cContextKeyLanguage).(string); ok && lang != "" {
+		langs = append(langs, lang)
+	}
+	if header, ok := ctx.Value(cContextKeyAcceptLanguage).(string); ok && header != "" {
+		langs = append(langs, ParseAcceptLanguage(header)...)
+	}
+	return langs
+}
+
+func NormalizeLanguage(lang string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-"))
+}
+
+func baseLanguage(lang string) string {
+	if idx := strings.IndexByte(lang, '-'); idx > 0 {
+		return lang[:idx]
+	}
+	return lang
+}
+
+// ParseAcceptLanguage parses header like `vi-VN,vi;q=0.9,en;q=0.8` and sorts languages by quality.
+func ParseAcceptLanguage(header string) []string {
+	type tLangQuality struct {
+		lang    string
+		quality float64
+	}
+	var items []tLangQuality
+	for _, part := range strings.Split(header, ",") {
+		var (
+			fields  = strings.Split(part, ";")
+			lang    = NormalizeLanguage(fields[0])
+			quality = 1.0
+		)
+		if lang == "" || lang == "*" {
+			continue
+		}
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			if q, err := strconv.ParseFloat(param[2:], 64); err == nil {
+				quality = q
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+		items = append(items, tLangQuality{lang, quality})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].quality > items[j].quality
+	})
+	langs := make([]string, len(items))
+	for i, item := range items {
+		langs[i] = item.lang
+	}
+	return langs
+}
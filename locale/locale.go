@@ -0,0 +1,41 @@
+package locale
+
+import (
+	"context"
+	"io/fs"
+)
+
+var vDefaultBundle = NewBundle(DefaultLanguage)
+
+func DefaultBundle() *Bundle {
+	return vDefaultBundle
+}
+
+// SetDefaultBundle must be called on initialization, it isn't safe for concurrent use.
+func SetDefaultBundle(bundle *Bundle) {
+	vDefaultBundle = bundle
+}
+
+func LoadFile(filePath string) error {
+	return vDefaultBundle.LoadFile(filePath)
+}
+
+func LoadFS(fsys fs.FS, dir string) error {
+	return vDefaultBundle.LoadFS(fsys, dir)
+}
+
+func LoadDir(dir string) error {
+	return vDefaultBundle.LoadDir(dir)
+}
+
+func AddMessages(lang string, messages map[string]any) error {
+	return vDefaultBundle.AddMessages(lang, messages)
+}
+
+func TranslateKey(ctx context.Context, key string) (string, error) {
+	return vDefaultBundle.TranslateKey(ctx, key)
+}
+
+func TranslateKeyData(ctx context.Context, key string, data map[string]any) (string, error) {
+	return vDefaultBundle.TranslateKeyData(ctx, key, data)
+}
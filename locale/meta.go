@@ -0,0 +1,21 @@
+package locale
+
+import "errors"
+
+const (
+	DefaultLanguage = "en"
+
+	pluralDataKey = "count"
+)
+
+type tContextKey byte
+
+const (
+	cContextKeyLanguage       tContextKey = 1
+	cContextKeyAcceptLanguage tContextKey = 2
+)
+
+var (
+	ErrNotFound        = errors.New("locale: translation not found")
+	ErrUnsupportedFile = errors.New("locale: unsupported bundle file")
+)
@@ -0,0 +1,105 @@
+package locale
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+type PluralForm string
+
+const (
+	PluralZero  PluralForm = "zero"
+	PluralOne   PluralForm = "one"
+	PluralTwo   PluralForm = "two"
+	PluralFew   PluralForm = "few"
+	PluralMany  PluralForm = "many"
+	PluralOther PluralForm = "other"
+)
+
+var vPluralForms = map[string]PluralForm{
+	string(PluralZero):  PluralZero,
+	string(PluralOne):   PluralOne,
+	string(PluralTwo):   PluralTwo,
+	string(PluralFew):   PluralFew,
+	string(PluralMany):  PluralMany,
+	string(PluralOther): PluralOther,
+}
+
+type PluralRule func(count float64) PluralForm
+
+var (
+	vPluralRulesMux sync.RWMutex
+	vPluralRules    = map[string]PluralRule{
+		"zh": pluralRuleNone,
+		"ja": pluralRuleNone,
+		"ko": pluralRuleNone,
+		"th": pluralRuleNone,
+		"vi": pluralRuleNone,
+		"id": pluralRuleNone,
+		"ms": pluralRuleNone,
+	}
+)
+
+// RegisterPluralRule overrides the rule of a language, English rule is used by default.
+func RegisterPluralRule(lang string, rule PluralRule) {
+	vPluralRulesMux.Lock()
+	defer vPluralRulesMux.Unlock()
+	vPluralRules[NormalizeLanguage(lang)] = rule
+}
+
+func getPluralRule(lang string) PluralRule {
+	vPluralRulesMux.RLock()
+	defer vPluralRulesMux.RUnlock()
+	if rule, ok := vPluralRules[lang]; ok {
+		return rule
+	}
+	if rule, ok := vPluralRules[baseLanguage(lang)]; ok {
+		return rule
+	}
+	return pluralRuleEnglish
+}
+
+func pluralRuleNone(float64) PluralForm {
+	return PluralOther
+}
+
+func pluralRuleEnglish(count float64) PluralForm {
+	if count == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// pluralCount supports numbers, numeric strings and any type has `Float64`/`String` like decimal.Decimal.
+func pluralCount(value any) (float64, bool) {
+	switch v := value.(type) {
+	case nil:
+		return 0, false
+	case interface{ Float64() (float64, bool) }:
+		f, _ := v.Float64()
+		return f, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+func selectPluralForm(lang string, count float64) PluralForm {
+	if count == 0 {
+		return PluralZero
+	}
+	return getPluralRule(lang)(math.Abs(count))
+}
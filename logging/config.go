@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+type Config struct {
+	Format    string                `json:"format"`
+	Level     slog.Level            `json:"level"`
+	AddSource bool                  `json:"add_source"`
+	Output    io.Writer             `json:"-"`
+	Levels    map[string]slog.Level `json:"levels"` // Levels per logger name, the longest prefix wins.
+}
+
+func (c Config) newHandler() slog.Handler {
+	var (
+		output = c.Output
+		opts   = &slog.HandlerOptions{
+			AddSource: c.AddSource,
+			Level:     slog.LevelDebug - 4,
+		}
+	)
+	if output == nil {
+		output = os.Stderr
+	}
+	if strings.EqualFold(c.Format, FormatText) {
+		return slog.NewTextHandler(output, opts)
+	}
+	return slog.NewJSONHandler(output, opts)
+}
+
+func (c Config) levelOf(name string) slog.Level {
+	var (
+		level     = c.Level
+		prefixLen = -1
+	)
+	for prefix, prefixLevel := range c.Levels {
+		if len(prefix) > prefixLen && strings.HasPrefix(name, prefix) {
+			level = prefixLevel
+			prefixLen = len(prefix)
+		}
+	}
+	return level
+}
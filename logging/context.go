@@ -0,0 +1,40 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ContextWithField attaches a request-scoped field which is added to every record logged with the context.
+func ContextWithField(ctx context.Context, key string, value any) context.Context {
+	return ContextWithAttrs(ctx, slog.Any(key, value))
+}
+
+func ContextWithFields(ctx context.Context, fields map[string]any) context.Context {
+	attrs := make([]slog.Attr, 0, len(fields))
+	for key, value := range fields {
+		attrs = append(attrs, slog.Any(key, value))
+	}
+	return ContextWithAttrs(ctx, attrs...)
+}
+
+func ContextWithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	if len(attrs) == 0 {
+		return ctx
+	}
+	var (
+		parentAttrs = ContextAttrs(ctx)
+		mergedAttrs = make([]slog.Attr, 0, len(parentAttrs)+len(attrs))
+	)
+	mergedAttrs = append(mergedAttrs, parentAttrs...)
+	mergedAttrs = append(mergedAttrs, attrs...)
+	return context.WithValue(ctx, cContextKeyFields, mergedAttrs)
+}
+
+func ContextAttrs(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	attrs, _ := ctx.Value(cContextKeyFields).([]slog.Attr)
+	return attrs
+}
@@ -0,0 +1,44 @@
+package logging
+
+import (
+	"errors"
+	"log/slog"
+	"sort"
+	"strconv"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+// errorAttr expands the data of all layers and the deepest stacktrace into a group
+// if any layer of the chain is an erroy.Error.
+func errorAttr(err error) slog.Attr {
+	var ourErr erroy.Error
+	if !errors.As(err, &ourErr) {
+		return slog.String(AttrKeyError, err.Error())
+	}
+	message := err.Error()
+	if outerErr, ok := err.(erroy.Error); ok {
+		message = outerErr.RawError()
+	}
+	attrs := []any{slog.String("message", message)}
+	if data := erroy.MergedData(err); len(data) > 0 {
+		keys := make([]string, 0, len(data))
+		for key := range data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		dataAttrs := make([]any, 0, len(keys))
+		for _, key := range keys {
+			dataAttrs = append(dataAttrs, slog.Any(key, erroy.RedactFieldValue(key, data[key])))
+		}
+		attrs = append(attrs, slog.Group(AttrKeyErrorData, dataAttrs...))
+	}
+	if stackFrames := erroy.DeepestStack(err).Frames(); len(stackFrames) > 0 {
+		frames := make([]string, len(stackFrames))
+		for i, frame := range stackFrames {
+			frames[i] = frame.Function + " " + frame.File + ":" + strconv.Itoa(frame.Line)
+		}
+		attrs = append(attrs, slog.Any(AttrKeyErrorStack, frames))
+	}
+	return slog.Group(AttrKeyError, attrs...)
+}
@@ -0,0 +1,41 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+// tHandler adds context fields and filters records by the level of the named logger,
+// the wrapped handler should accept all levels.
+type tHandler struct {
+	handler slog.Handler
+	level   slog.Leveler
+}
+
+var _ slog.Handler = tHandler{}
+
+func (h tHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level.Level() && h.handler.Enabled(ctx, level)
+}
+
+func (h tHandler) Handle(ctx context.Context, record slog.Record) error {
+	if attrs := ContextAttrs(ctx); len(attrs) > 0 {
+		record = record.Clone()
+		record.AddAttrs(attrs...)
+	}
+	return h.handler.Handle(ctx, record)
+}
+
+func (h tHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return tHandler{
+		handler: h.handler.WithAttrs(attrs),
+		level:   h.level,
+	}
+}
+
+func (h tHandler) WithGroup(name string) slog.Handler {
+	return tHandler{
+		handler: h.handler.WithGroup(name),
+		level:   h.level,
+	}
+}
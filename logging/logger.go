@@ -0,0 +1,142 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+)
+
+type Logger struct {
+	logger *slog.Logger
+	ctx    context.Context
+}
+
+var (
+	vConfigMux sync.RWMutex
+	vConfig    = Config{
+		Format: FormatJSON,
+		Level:  slog.LevelInfo,
+	}
+	vRootHandler = vConfig.newHandler()
+	vRootLogger  = newLogger("")
+)
+
+// Setup replaces the output configuration, it should be called on initialization
+// because loggers created before keep the old one.
+func Setup(config Config) {
+	vConfigMux.Lock()
+	defer vConfigMux.Unlock()
+	vConfig = config
+	vRootHandler = config.newHandler()
+	vRootLogger = newLoggerLocked("")
+}
+
+func newLogger(name string) Logger {
+	vConfigMux.RLock()
+	defer vConfigMux.RUnlock()
+	return newLoggerLocked(name)
+}
+
+// newLoggerLocked must be called with vConfigMux held.
+func newLoggerLocked(name string) Logger {
+	logger := slog.New(tHandler{
+		handler: vRootHandler,
+		level:   vConfig.levelOf(name),
+	})
+	if name != "" {
+		logger = logger.With(slog.String(AttrKeyLogger, name))
+	}
+	return Logger{logger: logger}
+}
+
+func GetLogger() Logger {
+	vConfigMux.RLock()
+	defer vConfigMux.RUnlock()
+	return vRootLogger
+}
+
+// GetNamedLogger returns logger which uses the level of its name (usually the package path) in Config.Levels.
+func GetNamedLogger(name string) Logger {
+	return newLogger(name)
+}
+
+func (l Logger) Slog() *slog.Logger {
+	return l.logger
+}
+
+func (l Logger) WithContext(ctx context.Context) Logger {
+	l.ctx = ctx
+	return l
+}
+
+func (l Logger) WithField(key string, value any) Logger {
+	l.logger = l.logger.With(slog.Any(key, value))
+	return l
+}
+
+func (l Logger) WithFields(fields map[string]any) Logger {
+	args := make([]any, 0, len(fields))
+	for key, value := range fields {
+		args = append(args, slog.Any(key, value))
+	}
+	l.logger = l.logger.With(args...)
+	return l
+}
+
+func (l Logger) WithError(err error) Logger {
+	if err == nil {
+		return l
+	}
+	l.logger = l.logger.With(errorAttr(err))
+	return l
+}
+
+// WithType marks the log with a category such as gconsts.LogTypeBlockchainScan.
+func (l Logger) WithType(logType string) Logger {
+	l.logger = l.logger.With(slog.String(AttrKeyType, logType))
+	return l
+}
+
+func (l Logger) context() context.Context {
+	if l.ctx == nil {
+		return context.Background()
+	}
+	return l.ctx
+}
+
+func (l Logger) log(level slog.Level, msg string) {
+	l.logger.Log(l.context(), level, msg)
+}
+
+func (l Logger) Debug(msg string) {
+	l.log(slog.LevelDebug, msg)
+}
+
+func (l Logger) Debugf(msg string, params ...any) {
+	l.log(slog.LevelDebug, fmt.Sprintf(msg, params...))
+}
+
+func (l Logger) Info(msg string) {
+	l.log(slog.LevelInfo, msg)
+}
+
+func (l Logger) Infof(msg string, params ...any) {
+	l.log(slog.LevelInfo, fmt.Sprintf(msg, params...))
+}
+
+func (l Logger) Warn(msg string) {
+	l.log(slog.LevelWarn, msg)
+}
+
+func (l Logger) Warnf(msg string, params ...any) {
+	l.log(slog.LevelWarn, fmt.Sprintf(msg, params...))
+}
+
+func (l Logger) Error(msg string) {
+	l.log(slog.LevelError, msg)
+}
+
+func (l Logger) Errorf(msg string, params ...any) {
+	l.log(slog.LevelError, fmt.Sprintf(msg, params...))
+}
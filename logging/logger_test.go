@@ -0,0 +1,145 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+func setupTest(t *testing.T, config Config) *bytes.Buffer {
+	t.Helper()
+	vConfigMux.RLock()
+	previous := vConfig
+	vConfigMux.RUnlock()
+	t.Cleanup(func() { Setup(previous) })
+
+	var output bytes.Buffer
+	config.Output = &output
+	Setup(config)
+	return &output
+}
+
+func decodeRecords(t *testing.T, output *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("decode %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestSetupLevels(t *testing.T) {
+	output := setupTest(t, Config{
+		Format: FormatJSON,
+		Level:  slog.LevelInfo,
+		Levels: map[string]slog.Level{
+			"github.com/acme":         slog.LevelWarn,
+			"github.com/acme/scanner": slog.LevelDebug,
+		},
+	})
+	tests := []struct {
+		name   string
+		logger Logger
+		level  slog.Level
+		logged bool
+	}{
+		{"root info", GetLogger(), slog.LevelInfo, true},
+		{"root debug", GetLogger(), slog.LevelDebug, false},
+		{"prefix warn", GetNamedLogger("github.com/acme/api"), slog.LevelWarn, true},
+		{"prefix info", GetNamedLogger("github.com/acme/api"), slog.LevelInfo, false},
+		{"longest prefix debug", GetNamedLogger("github.com/acme/scanner/btc"), slog.LevelDebug, true},
+		{"unmatched name", GetNamedLogger("github.com/other"), slog.LevelDebug, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output.Reset()
+			tt.logger.log(tt.level, "message")
+			if logged := output.Len() > 0; logged != tt.logged {
+				t.Fatalf("logged = %v, want %v: %s", logged, tt.logged, output)
+			}
+		})
+	}
+
+	output.Reset()
+	GetNamedLogger("github.com/acme/scanner").Debugf("block %d", 10)
+	records := decodeRecords(t, output)
+	if len(records) != 1 || records[0]["msg"] != "block 10" || records[0][AttrKeyLogger] != "github.com/acme/scanner" {
+		t.Fatalf("records = %v", records)
+	}
+}
+
+func TestSetupTextFormat(t *testing.T) {
+	output := setupTest(t, Config{Format: FormatText, Level: slog.LevelInfo})
+	GetLogger().WithField("order_id", 7).Info("created")
+	if line := output.String(); !strings.Contains(line, "msg=created") || !strings.Contains(line, "order_id=7") {
+		t.Fatalf("text output = %q", line)
+	}
+}
+
+func TestSetupConcurrent(t *testing.T) {
+	setupTest(t, Config{Format: FormatJSON, Level: slog.LevelInfo})
+	var (
+		wg     sync.WaitGroup
+		output bytes.Buffer
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			Setup(Config{Format: FormatJSON, Level: slog.LevelError, Output: &output})
+		}()
+		go func() {
+			defer wg.Done()
+			_ = GetLogger().Slog()
+			_ = GetNamedLogger("github.com/acme").Slog()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLoggerFields(t *testing.T) {
+	output := setupTest(t, Config{Format: FormatJSON, Level: slog.LevelDebug})
+	ctx := ContextWithField(context.Background(), "request_id", "r1")
+	err := erroy.NewWithStack("insufficient balance").WithField("wallet", "w1").WithField("password", "secret")
+	GetLogger().
+		WithContext(ctx).
+		WithType("scan").
+		WithFields(map[string]any{"height": 10}).
+		WithError(erroy.Wrap(err)).
+		Error("failed")
+	GetLogger().WithError(errors.New("plain")).Warn("plain error")
+
+	records := decodeRecords(t, output)
+	if len(records) != 2 {
+		t.Fatalf("records = %v", records)
+	}
+	record := records[0]
+	if record["request_id"] != "r1" || record[AttrKeyType] != "scan" || record["height"] != float64(10) || record["level"] != "ERROR" {
+		t.Fatalf("record = %v", record)
+	}
+	errorGroup, _ := record[AttrKeyError].(map[string]any)
+	data, _ := errorGroup[AttrKeyErrorData].(map[string]any)
+	if errorGroup["message"] != "insufficient balance" || data["wallet"] != "w1" || data["password"] == "secret" {
+		t.Fatalf("error = %v", errorGroup)
+	}
+	if frames, _ := errorGroup[AttrKeyErrorStack].([]any); len(frames) == 0 {
+		t.Fatalf("error has no stacktrace: %v", errorGroup)
+	}
+	if records[1][AttrKeyError] != "plain" {
+		t.Fatalf("plain error = %v", records[1][AttrKeyError])
+	}
+}
@@ -0,0 +1,20 @@
+package logging
+
+const (
+	AttrKeyType       = "type"
+	AttrKeyLogger     = "logger"
+	AttrKeyError      = "error"
+	AttrKeyErrorData  = "data"
+	AttrKeyErrorStack = "stacktrace"
+)
+
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+type tContextKey byte
+
+const (
+	cContextKeyFields tContextKey = 1
+)
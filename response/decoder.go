@@ -0,0 +1,89 @@
+package response
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+	"github.com/kiyuu10/common-lib-go/gconsts"
+	"github.com/kiyuu10/common-lib-go/gmeta"
+)
+
+func readBody(resp *http.Response) (_ []byte, err error) {
+	defer resp.Body.Close()
+	var reader io.Reader = resp.Body
+	// http.Transport only decompresses transparently when it adds `Accept-Encoding` itself.
+	if strings.EqualFold(resp.Header.Get(HeaderContentEncoding), gconsts.HttpContentEncodingGzip) {
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, erroy.WrapStack(err, "response: open gzip reader")
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, erroy.WrapStack(err, "response: read body")
+	}
+	return data, nil
+}
+
+// DecodeEnvelope reads the response, a failed envelope is returned as OurError
+// which wraps the remote message and keeps the remote data.
+func DecodeEnvelope[T any](resp *http.Response) (envelope Envelope[T], err error) {
+	body, err := readBody(resp)
+	if err != nil {
+		return
+	}
+	var rawEnvelope Envelope[json.RawMessage]
+	if err = json.Unmarshal(body, &rawEnvelope); err != nil || rawEnvelope.Code == "" {
+		return envelope, erroy.WrapMessage(gconsts.ErrorDependency.Wrap(err), "response: decode envelope").
+			WithField("status", resp.StatusCode).
+			WithField("body", string(body))
+	}
+	envelope.Code = rawEnvelope.Code
+	envelope.Message = rawEnvelope.Message
+	envelope.Paging = rawEnvelope.Paging
+
+	if !rawEnvelope.IsSuccess() {
+		var data gmeta.O
+		if len(rawEnvelope.Data) > 0 {
+			if err = json.Unmarshal(rawEnvelope.Data, &data); err != nil {
+				return envelope, erroy.WrapStack(err, "response: decode error data").
+					WithField("code", rawEnvelope.Code)
+			}
+		}
+		err = gmeta.NewOurError(rawEnvelope.Code).
+			WithData(data).
+			Wrap(gmeta.NewMessageError("%s", rawEnvelope.Message))
+		return
+	}
+	if len(rawEnvelope.Data) > 0 {
+		if err = json.Unmarshal(rawEnvelope.Data, &envelope.Data); err != nil {
+			return envelope, erroy.WrapStack(err, "response: decode data")
+		}
+	}
+	return envelope, nil
+}
+
+func Decode[T any](resp *http.Response) (data T, err error) {
+	envelope, err := DecodeEnvelope[T](resp)
+	if err != nil {
+		return
+	}
+	return envelope.Data, nil
+}
+
+func DecodeList[T any](resp *http.Response) (items []T, paging gmeta.Paging, err error) {
+	envelope, err := DecodeEnvelope[[]T](resp)
+	if err != nil {
+		return
+	}
+	if envelope.Paging != nil {
+		paging = *envelope.Paging
+	}
+	return envelope.Data, paging, nil
+}
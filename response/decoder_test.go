@@ -0,0 +1,104 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kiyuu10/common-lib-go/gconsts"
+	"github.com/kiyuu10/common-lib-go/gmeta"
+)
+
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// get sets Accept-Encoding itself so http.Transport doesn't decompress the body.
+func get(t *testing.T, url string, acceptEncoding string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acceptEncoding != "" {
+		req.Header.Set(HeaderAcceptEncoding, acceptEncoding)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func TestDecode(t *testing.T) {
+	type tItem struct {
+		ID int `json:"id"`
+	}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = WriteSuccess(w, r, tItem{ID: 1})
+	})
+	for _, acceptEncoding := range []string{"", "gzip", "gzip;q=0.0"} {
+		resp := get(t, server.URL, acceptEncoding)
+		item, err := Decode[tItem](resp)
+		if err != nil || item.ID != 1 {
+			t.Fatalf("Decode with %q = %+v, %v", acceptEncoding, item, err)
+		}
+	}
+}
+
+func TestDecodeZeroData(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = WriteSuccess(w, r, 0)
+	})
+	envelope, err := DecodeEnvelope[*int](get(t, server.URL, "gzip"))
+	if err != nil || envelope.Data == nil || *envelope.Data != 0 {
+		t.Fatalf("DecodeEnvelope = %+v, %v", envelope, err)
+	}
+}
+
+func TestDecodeList(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = WriteList(w, r, []string{"a", "b"}, gmeta.Paging{Limit: 2})
+	})
+	items, paging, err := DecodeList[string](get(t, server.URL, "gzip"))
+	if err != nil || len(items) != 2 || items[1] != "b" || paging.Limit != 2 {
+		t.Fatalf("DecodeList = %v, %+v, %v", items, paging, err)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = WriteError(w, r, gconsts.ErrorInvalidParams.WithData(gmeta.O{"field": "amount"}))
+	})
+	_, err := Decode[int](get(t, server.URL, "gzip"))
+	ourErr, ok := gmeta.FindOurError(err)
+	if !ok || ourErr.Code() != gconsts.ErrorCodeInvalidParams || ourErr.Data()["field"] != "amount" {
+		t.Fatalf("Decode error = %v", err)
+	}
+}
+
+func TestDecodeInvalidBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"not json", `not json`},
+		{"no code", `{"data":1}`},
+		{"invalid error data", `{"code":"error_invalid_params","data":"amount"}`},
+		{"invalid data", `{"code":"success","data":"text"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(tt.body))
+			})
+			_, err := Decode[int](get(t, server.URL, ""))
+			if err == nil {
+				t.Fatal("Decode must fail")
+			}
+		})
+	}
+}
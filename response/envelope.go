@@ -0,0 +1,48 @@
+package response
+
+import (
+	"context"
+
+	"github.com/kiyuu10/common-lib-go/gconsts"
+	"github.com/kiyuu10/common-lib-go/gmeta"
+)
+
+// Envelope is the standard JSON body of every API, it shares the shape of gmeta.ErrorEnvelope.
+type Envelope[T any] struct {
+	Code    gmeta.ErrorCode `json:"code"`
+	Message string          `json:"message,omitempty"`
+	Data    T               `json:"data"`
+	Paging  *gmeta.Paging   `json:"paging,omitempty"`
+}
+
+func (e Envelope[T]) IsSuccess() bool {
+	return e.Code == gconsts.ErrorCodeSuccess
+}
+
+func Success[T any](data T) Envelope[T] {
+	return Envelope[T]{
+		Code: gconsts.ErrorCodeSuccess,
+		Data: data,
+	}
+}
+
+func List[T any](items []T, paging gmeta.Paging) Envelope[[]T] {
+	if items == nil {
+		items = make([]T, 0)
+	}
+	return Envelope[[]T]{
+		Code:   gconsts.ErrorCodeSuccess,
+		Data:   items,
+		Paging: &paging,
+	}
+}
+
+// Error converts any error to the envelope with localized message and its HTTP status.
+func Error(ctx context.Context, err error) (int, Envelope[gmeta.O]) {
+	status, errEnvelope := gconsts.NewErrorEnvelope(ctx, err)
+	return status, Envelope[gmeta.O]{
+		Code:    errEnvelope.Code,
+		Message: errEnvelope.Message,
+		Data:    errEnvelope.Data,
+	}
+}
@@ -0,0 +1,98 @@
+package response
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+	"github.com/kiyuu10/common-lib-go/gconsts"
+	"github.com/kiyuu10/common-lib-go/gmeta"
+)
+
+const (
+	HeaderContentType     = "Content-Type"
+	HeaderContentEncoding = "Content-Encoding"
+	HeaderAcceptEncoding  = "Accept-Encoding"
+	HeaderVary            = "Vary"
+
+	ContentTypeJSON = "application/json; charset=utf-8"
+)
+
+func acceptsGzip(r *http.Request) bool {
+	if r == nil {
+		return false
+	}
+	for _, encoding := range strings.Split(r.Header.Get(HeaderAcceptEncoding), ",") {
+		encoding, params, _ := strings.Cut(strings.TrimSpace(encoding), ";")
+		if !strings.EqualFold(strings.TrimSpace(encoding), gconsts.HttpContentEncodingGzip) {
+			continue
+		}
+		return encodingQuality(params) > 0
+	}
+	return false
+}
+
+// encodingQuality returns the `q` param, 1 if it's absent and 0 if it's invalid.
+func encodingQuality(params string) float64 {
+	for _, param := range strings.Split(params, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if !strings.EqualFold(strings.TrimSpace(key), "q") {
+			continue
+		}
+		quality, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return 0
+		}
+		return quality
+	}
+	return 1
+}
+
+// Write encodes body as JSON, the body is compressed by gzip if the request accepts it.
+func Write(w http.ResponseWriter, r *http.Request, status int, body any) (err error) {
+	header := w.Header()
+	header.Set(HeaderContentType, ContentTypeJSON)
+	header.Add(HeaderVary, HeaderAcceptEncoding)
+
+	var writer io.Writer = w
+	if acceptsGzip(r) {
+		header.Set(HeaderContentEncoding, gconsts.HttpContentEncodingGzip)
+		header.Del("Content-Length")
+		gzipWriter := gzip.NewWriter(w)
+		defer func() {
+			if closeErr := gzipWriter.Close(); closeErr != nil && err == nil {
+				err = erroy.WrapStack(closeErr, "response: close gzip writer")
+			}
+		}()
+		writer = gzipWriter
+	}
+
+	w.WriteHeader(status)
+	if err = json.NewEncoder(writer).Encode(body); err != nil {
+		return erroy.WrapStack(err, "response: encode json")
+	}
+	return nil
+}
+
+func WriteSuccess[T any](w http.ResponseWriter, r *http.Request, data T) error {
+	return Write(w, r, http.StatusOK, Success(data))
+}
+
+func WriteList[T any](w http.ResponseWriter, r *http.Request, items []T, paging gmeta.Paging) error {
+	return Write(w, r, http.StatusOK, List(items, paging))
+}
+
+// WriteError uses the request context to localize the message, nil request falls back to the default locale.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) error {
+	ctx := context.Background()
+	if r != nil {
+		ctx = r.Context()
+	}
+	status, envelope := Error(ctx, err)
+	return Write(w, r, status, envelope)
+}
@@ -0,0 +1,114 @@
+package response
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kiyuu10/common-lib-go/gconsts"
+	"github.com/kiyuu10/common-lib-go/gmeta"
+)
+
+func TestAcceptsGzip(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		accepted       bool
+	}{
+		{"", false},
+		{"gzip", true},
+		{"GZIP", true},
+		{"deflate, gzip;q=0.5", true},
+		{"br;q=1.0, gzip ; q=0.001", true},
+		{"gzip;q=0", false},
+		{"gzip;q=0.0", false},
+		{"gzip;q=0.000", false},
+		{"gzip; q=0", false},
+		{"gzip;Q=0", false},
+		{"gzip;q=invalid", false},
+		{"deflate, br", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.acceptEncoding, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set(HeaderAcceptEncoding, tt.acceptEncoding)
+			if accepted := acceptsGzip(r); accepted != tt.accepted {
+				t.Fatalf("acceptsGzip(%q) = %v, want %v", tt.acceptEncoding, accepted, tt.accepted)
+			}
+		})
+	}
+	if acceptsGzip(nil) {
+		t.Fatal("nil request must not accept gzip")
+	}
+}
+
+func TestWriteSuccessZeroData(t *testing.T) {
+	tests := []struct {
+		name string
+		data any
+		body string
+	}{
+		{"zero int", 0, `{"code":"success","data":0}`},
+		{"false", false, `{"code":"success","data":false}`},
+		{"empty string", "", `{"code":"success","data":""}`},
+		{"empty struct", struct{}{}, `{"code":"success","data":{}}`},
+		{"nil", nil, `{"code":"success","data":null}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			if err := WriteSuccess(recorder, nil, tt.data); err != nil {
+				t.Fatal(err)
+			}
+			if body := strings.TrimSpace(recorder.Body.String()); body != tt.body {
+				t.Fatalf("body = %s, want %s", body, tt.body)
+			}
+		})
+	}
+}
+
+func TestWriteGzip(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set(HeaderAcceptEncoding, "gzip")
+	recorder := httptest.NewRecorder()
+	if err := WriteList(recorder, r, []int{1, 2}, gmeta.Paging{Limit: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if recorder.Header().Get(HeaderContentEncoding) != gconsts.HttpContentEncodingGzip ||
+		recorder.Header().Get(HeaderVary) != HeaderAcceptEncoding ||
+		recorder.Header().Get(HeaderContentType) != ContentTypeJSON {
+		t.Fatalf("headers = %v", recorder.Header())
+	}
+	gzipReader, err := gzip.NewReader(recorder.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(gzipReader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(body), `{"code":"success","data":[1,2],"paging":{`) {
+		t.Fatalf("body = %s", body)
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	err := gconsts.ErrorInvalidParams.WithData(gmeta.O{"field": "amount"}).Wrap(errors.New("negative amount"))
+	if writeErr := WriteError(recorder, nil, err); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+	if recorder.Code != gconsts.ErrorCodeInvalidParams.HttpStatus() {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if recorder.Header().Get(HeaderContentEncoding) != "" {
+		t.Fatal("nil request must not get gzip")
+	}
+	body := recorder.Body.String()
+	if !strings.Contains(body, `"code":"error_invalid_params"`) || !strings.Contains(body, `"data":{"field":"amount"}`) {
+		t.Fatalf("body = %s", body)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	atomicobj "go.uber.org/atomic"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
 )
 
 const (
@@ -27,6 +29,10 @@ var (
 	}
 )
 
+func init() {
+	erroy.RegisterRetryableError(ObjPoolErrPoolTimeout)
+}
+
 type ObjectPool[T any] interface {
 	NewObject(context.Context) (*ObjectPoolItem[T], error)
 	CloseObject(*ObjectPoolItem[T]) error
@@ -146,7 +152,9 @@ func NewSimpleObjectPool[T any](
 	pool.objectsMux.Unlock()
 
 	if opts.IdleTimeout > 0 && opts.IdleReapInterval > 0 {
-		go pool.reaper(opts.IdleReapInterval)
+		erroy.Go(func() {
+			pool.reaper(opts.IdleReapInterval)
+		})
 	}
 
 	return pool
@@ -159,14 +167,14 @@ func (p *SimpleObjectPool[T]) checkMinIdleObjects() {
 	for p.size < p.opts.PoolSize && p.idleCount < p.opts.MinIdleCount {
 		p.size++
 		p.idleCount++
-		go func() {
-			if err := p.addIdleObject(); err != nil {
+		erroy.Go(func() {
+			if err := erroy.Safe(p.addIdleObject); err != nil {
 				p.objectsMux.Lock()
 				p.size--
 				p.idleCount--
 				p.objectsMux.Unlock()
 			}
-		}()
+		})
 	}
 }
 
@@ -4,13 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/url"
 	"time"
 
 	"github.com/getsentry/sentry-go"
 	"github.com/go-redis/redis/v8"
 
-	"gitea.alchemymagic.app/snap/go-common/erroy"
+	"github.com/kiyuu10/common-lib-go/erroy"
 )
 
 type RedisOptions struct {
@@ -33,6 +34,7 @@ type RedisOptions struct {
 func NewRedisClient(opts redis.Options) *redis.Client {
 	var client = redis.NewClient(&opts)
 	client.AddHook(RedisSentryHook{})
+	client.AddHook(RedisRetryableHook{})
 	return client
 }
 
@@ -135,3 +137,47 @@ func (rsh RedisSentryHook) BeforeProcessPipeline(ctx context.Context, _ []redis.
 func (rsh RedisSentryHook) AfterProcessPipeline(_ context.Context, _ []redis.Cmder) error {
 	return nil
 }
+
+// WrapRedisError marks a lost connection as retryable, go-redis reports it by EOF like its own retry policy does.
+// EOF isn't registered globally because it's the normal end of other reads.
+func WrapRedisError(err error) error {
+	if isRedisConnectionLost(err) {
+		return erroy.Wrap(err).WithRetryable(true)
+	}
+	return err
+}
+
+func isRedisConnectionLost(err error) bool {
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// RedisRetryableHook applies WrapRedisError on command errors.
+type RedisRetryableHook struct{}
+
+func (RedisRetryableHook) BeforeProcess(ctx context.Context, _ redis.Cmder) (context.Context, error) {
+	return ctx, nil
+}
+
+func (RedisRetryableHook) AfterProcess(_ context.Context, cmd redis.Cmder) error {
+	if err := cmd.Err(); isRedisConnectionLost(err) {
+		return WrapRedisError(err)
+	}
+	return nil
+}
+
+func (RedisRetryableHook) BeforeProcessPipeline(ctx context.Context, _ []redis.Cmder) (context.Context, error) {
+	return ctx, nil
+}
+
+func (RedisRetryableHook) AfterProcessPipeline(_ context.Context, cmds []redis.Cmder) error {
+	var firstErr error
+	for _, cmd := range cmds {
+		if err := cmd.Err(); isRedisConnectionLost(err) {
+			cmd.SetErr(WrapRedisError(err))
+			if firstErr == nil {
+				firstErr = cmd.Err()
+			}
+		}
+	}
+	return firstErr
+}
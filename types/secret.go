@@ -6,11 +6,12 @@ import (
 	"encoding/hex"
 	"net/url"
 	"os"
+	"reflect"
 	"strings"
 	"sync"
 	"time"
 
-	"gitea.alchemymagic.app/snap/go-common/erroy"
+	"github.com/kiyuu10/common-lib-go/erroy"
 )
 
 type (
@@ -23,6 +24,10 @@ type (
 	}
 )
 
+func init() {
+	erroy.RegisterRedactedType(reflect.TypeFor[Secret](), reflect.TypeFor[SecretValue]())
+}
+
 type PlaceholderSecret struct {
 	secret Secret
 }
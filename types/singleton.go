@@ -32,6 +32,13 @@ func (s *Singleton[T]) Get() T {
 	return s.instance
 }
 
+// Loaded returns the instance without loading it.
+func (s *Singleton[T]) Loaded() (instance T, ok bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.instance, s.isLoaded
+}
+
 type SingletonMap[T any] struct {
 	mux         sync.Mutex
 	instanceMap map[string]T
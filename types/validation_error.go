@@ -0,0 +1,135 @@
+package types
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+	"github.com/kiyuu10/common-lib-go/locale"
+)
+
+const (
+	ValidationMessageKeyPrefix = "validation."
+
+	validationMultiErrorRule = "error"
+)
+
+// vValidationDefaultMessages are used when the locale bundle has no `validation.<rule>` key.
+var vValidationDefaultMessages = map[string]string{
+	"required": "{{.field}} is required",
+	"email":    "{{.field}} must be a valid email",
+	"min":      "{{.field}} must be at least {{.param}}",
+	"max":      "{{.field}} must be at most {{.param}}",
+	"len":      "{{.field}} must have length {{.param}}",
+	"gt":       "{{.field}} must be greater than {{.param}}",
+	"gte":      "{{.field}} must be greater than or equal to {{.param}}",
+	"lt":       "{{.field}} must be less than {{.param}}",
+	"lte":      "{{.field}} must be less than or equal to {{.param}}",
+	"oneof":    "{{.field}} must be one of [{{.param}}]",
+}
+
+// ValidatorRegisterDefaultMessage sets the fallback template of a custom rule, it should be called on initialization.
+func ValidatorRegisterDefaultMessage(rule string, template string) {
+	vValidationDefaultMessages[rule] = template
+}
+
+type (
+	ValidationFieldError struct {
+		Field   string `json:"field"` // JSON path such as `items[0].amount`.
+		Rule    string `json:"rule"`
+		Param   string `json:"param,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+	ValidationFieldErrors []ValidationFieldError
+)
+
+func NewValidationFieldErrors(errs validator.ValidationErrors) ValidationFieldErrors {
+	fieldErrs := make(ValidationFieldErrors, len(errs))
+	for i, err := range errs {
+		fieldErrs[i] = ValidationFieldError{
+			Field: validationFieldPath(err.Namespace()),
+			Rule:  err.Tag(),
+			Param: err.Param(),
+		}
+	}
+	return fieldErrs
+}
+
+// NewMultiValidationFieldErrors uses keys as field names, the rule is the code of the error
+// (erroy.Coder) or "error" and the message is the raw error.
+func NewMultiValidationFieldErrors(multiErr *erroy.MultiError) ValidationFieldErrors {
+	items := multiErr.Items()
+	fieldErrs := make(ValidationFieldErrors, len(items))
+	for i, item := range items {
+		fieldErr := ValidationFieldError{
+			Field:   item.Key,
+			Rule:    validationMultiErrorRule,
+			Message: item.Err.Error(),
+		}
+		if coder, ok := item.Err.(erroy.Coder); ok {
+			fieldErr.Rule = coder.ErrorCode()
+		}
+		if ourErr, ok := item.Err.(erroy.Error); ok {
+			fieldErr.Message = ourErr.RawError()
+		}
+		fieldErrs[i] = fieldErr
+	}
+	return fieldErrs
+}
+
+// validationFieldPath removes the root struct name from the namespace.
+func validationFieldPath(namespace string) string {
+	if idx := strings.IndexByte(namespace, '.'); idx >= 0 {
+		return namespace[idx+1:]
+	}
+	return namespace
+}
+
+func (errs ValidationFieldErrors) Error() string {
+	parts := make([]string, len(errs))
+	for i, err := range errs {
+		parts[i] = err.Field + ":" + err.Rule
+		if err.Param != "" {
+			parts[i] += "=" + err.Param
+		}
+	}
+	return "validation failed: " + strings.Join(parts, ",")
+}
+
+// Localize returns a copy with messages translated by `validation.<rule>` keys,
+// the templates receive `field` and `param`.
+func (errs ValidationFieldErrors) Localize(ctx context.Context) ValidationFieldErrors {
+	localized := make(ValidationFieldErrors, len(errs))
+	for i, err := range errs {
+		err.Message = err.localize(ctx)
+		localized[i] = err
+	}
+	return localized
+}
+
+// LocalizeData implements gmeta.DataLocalizer.
+func (errs ValidationFieldErrors) LocalizeData(ctx context.Context) any {
+	return errs.Localize(ctx)
+}
+
+func (e ValidationFieldError) localize(ctx context.Context) string {
+	data := map[string]any{
+		"field": e.Field,
+		"param": e.Param,
+	}
+	message, err := locale.TranslateKeyData(ctx, ValidationMessageKeyPrefix+e.Rule, data)
+	if err == nil {
+		return message
+	}
+	template, ok := vValidationDefaultMessages[e.Rule]
+	if !ok {
+		if e.Message != "" {
+			return e.Message
+		}
+		template = "{{.field}} failed on rule " + e.Rule
+	}
+	replacer := strings.NewReplacer("{{.field}}", e.Field, "{{.param}}", e.Param)
+	return replacer.Replace(template)
+}
@@ -1,12 +1,14 @@
 package types
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/shopspring/decimal"
 
-	"gitea.alchemymagic.app/snap/go-common/erroy"
+	"github.com/kiyuu10/common-lib-go/erroy"
 )
 
 type (
@@ -17,11 +19,22 @@ type (
 		Func      validator.CustomTypeFunc
 		SampleObj any
 	}
+	tValidatorValidation struct {
+		Tag  string
+		Func validator.Func
+	}
+	tValidatorStructValidation struct {
+		Func       validator.StructLevelFunc
+		SampleObjs []any
+	}
 )
 
 var (
-	DefaultValidator      = NewSingleton(func() Validator { return NewValidator() })
-	vValidatorCustomTypes []tValidatorCustomType
+	DefaultValidator         = NewSingleton(func() Validator { return NewValidator() })
+	vValidatorCustomTypes    []tValidatorCustomType
+	vValidatorValidations    []tValidatorValidation
+	vValidatorStructs        []tValidatorStructValidation
+	vValidatorErrorConverter func(fieldErrs ValidationFieldErrors) error
 )
 
 func NewValidator() Validator {
@@ -31,12 +44,33 @@ func NewValidator() Validator {
 }
 
 func (v Validator) init() {
+	v.RegisterTagNameFunc(v.jsonFieldName)
 	v.RegisterCustomTypeFunc(v.extractDecimal, decimal.Decimal{})
 	v.RegisterCustomTypeFunc(v.extractNullInt64, NullInt64{})
 	v.RegisterCustomTypeFunc(v.extractTimeDuration, TimeDuration{})
 	for _, t := range vValidatorCustomTypes {
 		v.RegisterCustomTypeFunc(t.Func, t.SampleObj)
 	}
+	for _, t := range vValidatorValidations {
+		if err := v.RegisterValidation(t.Tag, t.Func); err != nil {
+			panic(erroy.WrapStack(err, "validator: register validation").WithField("tag", t.Tag))
+		}
+	}
+	for _, t := range vValidatorStructs {
+		v.RegisterStructValidation(t.Func, t.SampleObjs...)
+	}
+}
+
+func (v Validator) jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	default:
+		return name
+	}
 }
 
 func (v Validator) extractDecimal(field reflect.Value) any {
@@ -68,16 +102,58 @@ func ValidatorRegisterCustomType(fn validator.CustomTypeFunc, sampleObj any) {
 	})
 }
 
+// ValidatorRegisterValidation adds a tag to validators created later and to DefaultValidator if it's already created,
+// it isn't safe to call while validating so it should be called on initialization.
+func ValidatorRegisterValidation(tag string, fn validator.Func) {
+	vValidatorValidations = append(vValidatorValidations, tValidatorValidation{
+		Tag:  tag,
+		Func: fn,
+	})
+	if v, ok := DefaultValidator.Loaded(); ok {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			panic(erroy.WrapStack(err, "validator: register validation").WithField("tag", tag))
+		}
+	}
+}
+
+// ValidatorRegisterStructValidation also applies to DefaultValidator if it's already created.
+func ValidatorRegisterStructValidation(fn validator.StructLevelFunc, sampleObjs ...any) {
+	vValidatorStructs = append(vValidatorStructs, tValidatorStructValidation{
+		Func:       fn,
+		SampleObjs: sampleObjs,
+	})
+	if v, ok := DefaultValidator.Loaded(); ok {
+		v.RegisterStructValidation(fn, sampleObjs...)
+	}
+}
+
+// ValidatorRegisterErrorConverter sets how field errors are returned, e.g. gconsts wraps them in ErrorInvalidParams.
+func ValidatorRegisterErrorConverter(fn func(fieldErrs ValidationFieldErrors) error) {
+	vValidatorErrorConverter = fn
+}
+
+func convertValidationError(err error) error {
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return err
+	}
+	fieldErrs := NewValidationFieldErrors(validationErrs)
+	if vValidatorErrorConverter == nil {
+		return fieldErrs
+	}
+	return vValidatorErrorConverter(fieldErrs)
+}
+
 func ValidateStruct(value any) error {
 	if err := DefaultValidator.Get().Struct(value); err != nil {
-		return erroy.WrapStack(err, "validate struct")
+		return erroy.WrapStack(convertValidationError(err), "validate struct")
 	}
 	return nil
 }
 
 func ValidateValue(value any, tag string) error {
 	if err := DefaultValidator.Get().Var(value, tag); err != nil {
-		return erroy.WrapStack(err, "validate "+tag+" value ")
+		return erroy.WrapStack(convertValidationError(err), "validate "+tag+" value ")
 	}
 	return nil
 }
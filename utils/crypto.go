@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+func RandomBytes(length int) ([]byte, error) {
+	data := make([]byte, length)
+	if _, err := rand.Read(data); err != nil {
+		return nil, erroy.WrapStack(err, "random bytes: read")
+	}
+	return data, nil
+}
+
+func RandomBytesF(length int) []byte {
+	data, err := RandomBytes(length)
+	PanicOnError(err)
+	return data
+}
+
+func newAesGcm(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, erroy.WrapStack(err, "aes gcm: create cipher block")
+	}
+	aesGcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, erroy.WrapStack(err, "aes gcm: create gcm")
+	}
+	return aesGcm, nil
+}
+
+func AesGcmEncrypt(key []byte, nonce []byte, data []byte) ([]byte, error) {
+	aesGcm, err := newAesGcm(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aesGcm.NonceSize() {
+		return nil, erroy.NewWithStack("aes gcm: invalid nonce size").
+			WithField("expected", aesGcm.NonceSize()).
+			WithField("actual", len(nonce))
+	}
+	return aesGcm.Seal(nil, nonce, data, nil), nil
+}
+
+func AesGcmDecrypt(key []byte, nonce []byte, cipherData []byte) ([]byte, error) {
+	aesGcm, err := newAesGcm(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aesGcm.NonceSize() {
+		return nil, erroy.NewWithStack("aes gcm: invalid nonce size").
+			WithField("expected", aesGcm.NonceSize()).
+			WithField("actual", len(nonce))
+	}
+	data, err := aesGcm.Open(nil, nonce, cipherData, nil)
+	if err != nil {
+		return nil, erroy.WrapStack(err, "aes gcm: open cipher data")
+	}
+	return data, nil
+}
+
+func Sha256(data []byte) []byte {
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+func Sha256Hex(data []byte) string {
+	return hex.EncodeToString(Sha256(data))
+}
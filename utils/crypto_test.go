@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSha256Hex(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"empty", "", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{"abc", "abc", "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sha256Hex([]byte(tt.data)); got != tt.want {
+				t.Errorf("Sha256Hex(%q) = %s, want %s", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRandomBytes(t *testing.T) {
+	first, second := RandomBytesF(32), RandomBytesF(32)
+	if len(first) != 32 || len(second) != 32 {
+		t.Fatalf("lengths are %d and %d, want 32", len(first), len(second))
+	}
+	if bytes.Equal(first, second) {
+		t.Fatal("random bytes are repeated")
+	}
+}
+
+func TestAesGcm(t *testing.T) {
+	var (
+		key   = bytes.Repeat([]byte{1}, 32)
+		nonce = bytes.Repeat([]byte{2}, 12)
+		data  = []byte("secret data")
+	)
+	cipherData, err := AesGcmEncrypt(key, nonce, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plainData, err := AesGcmDecrypt(key, nonce, cipherData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(plainData, data) {
+		t.Fatalf("decrypted %q, want %q", plainData, data)
+	}
+
+	tamperedData := bytes.Clone(cipherData)
+	tamperedData[0] ^= 0xff
+	tests := []struct {
+		name       string
+		key        []byte
+		nonce      []byte
+		cipherData []byte
+	}{
+		{"invalid key size", key[:7], nonce, cipherData},
+		{"invalid nonce size", key, nonce[:4], cipherData},
+		{"wrong key", bytes.Repeat([]byte{3}, 32), nonce, cipherData},
+		{"tampered data", key, nonce, tamperedData},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := AesGcmDecrypt(tt.key, tt.nonce, tt.cipherData); err == nil {
+				t.Error("error is expected")
+			}
+		})
+	}
+	if _, err := AesGcmEncrypt(key, nonce[:4], data); err == nil {
+		t.Error("encrypting with an invalid nonce size must fail")
+	}
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+const (
+	DecimalDividePrecision = 18
+)
+
+// DecimalDivide keeps the precision of crypto amounts, which is not guaranteed by `decimal.Div`.
+// Dividing by zero returns zero instead of panicking.
+func DecimalDivide(value decimal.Decimal, divisor decimal.Decimal) decimal.Decimal {
+	if divisor.IsZero() {
+		return decimal.Zero
+	}
+	return value.DivRound(divisor, DecimalDividePrecision)
+}
+
+func ParseDecimal(text string) (decimal.Decimal, error) {
+	value, err := decimal.NewFromString(text)
+	if err != nil {
+		return decimal.Zero, erroy.WrapStack(err, "parse decimal").WithField("text", text)
+	}
+	return value, nil
+}
+
+func ParseDecimalF(text string) decimal.Decimal {
+	value, err := ParseDecimal(text)
+	PanicOnError(err)
+	return value
+}
+
+func DecimalMin(first decimal.Decimal, others ...decimal.Decimal) decimal.Decimal {
+	return decimal.Min(first, others...)
+}
+
+func DecimalMax(first decimal.Decimal, others ...decimal.Decimal) decimal.Decimal {
+	return decimal.Max(first, others...)
+}
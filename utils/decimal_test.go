@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDecimalDivide(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		divisor string
+		want    string
+	}{
+		{"exact", "10", "4", "2.5"},
+		{"repeating", "1", "3", "0.333333333333333333"},
+		{"zero divisor", "1", "0", "0"},
+		{"negative", "-1", "8", "-0.125"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DecimalDivide(ParseDecimalF(tt.value), ParseDecimalF(tt.divisor))
+			if !got.Equal(ParseDecimalF(tt.want)) {
+				t.Errorf("DecimalDivide(%s, %s) = %s, want %s", tt.value, tt.divisor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{"1.5", "1.5", false},
+		{"-0.000000000000000001", "-0.000000000000000001", false},
+		{"", "", true},
+		{"1,5", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseDecimal(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDecimal(%q) error = %v, wantErr %v", tt.text, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("ParseDecimal(%q) = %s, want %s", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalMinMax(t *testing.T) {
+	values := []decimal.Decimal{decimal.NewFromInt(3), decimal.NewFromInt(-2), decimal.NewFromInt(7)}
+	if got := DecimalMin(values[0], values[1:]...); !got.Equal(decimal.NewFromInt(-2)) {
+		t.Errorf("DecimalMin = %s, want -2", got)
+	}
+	if got := DecimalMax(values[0], values[1:]...); !got.Equal(decimal.NewFromInt(7)) {
+		t.Errorf("DecimalMax = %s, want 7", got)
+	}
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+func Base64Encode(data []byte) string {
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func Base64Decode(text string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(text)
+	if err != nil {
+		return nil, erroy.WrapStack(err, "base64: decode")
+	}
+	return data, nil
+}
+
+func Base64UrlEncode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func Base64UrlDecode(text string) ([]byte, error) {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(text, "="))
+	if err != nil {
+		return nil, erroy.WrapStack(err, "base64 url: decode")
+	}
+	return data, nil
+}
+
+func HexEncode(data []byte) string {
+	return hex.EncodeToString(data)
+}
+
+func HexDecode(text string) ([]byte, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(text, "0x"))
+	if err != nil {
+		return nil, erroy.WrapStack(err, "hex: decode")
+	}
+	return data, nil
+}
+
+func JsonEncode(value any) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, erroy.WrapStack(err, "json: encode")
+	}
+	return data, nil
+}
+
+// JsonEncodeF is used for logging and messages only, the error is rendered into the result.
+func JsonEncodeF(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "<json: " + err.Error() + ">"
+	}
+	return string(data)
+}
+
+func JsonDecode(data []byte, value any) error {
+	if err := json.Unmarshal(data, value); err != nil {
+		return erroy.WrapStack(err, "json: decode")
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBase64(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		std     string
+		url     string
+		urlText string
+	}{
+		{"empty", []byte{}, "", "", ""},
+		{"padded", []byte{0xfb, 0xff}, "+/8=", "-_8", "-_8="},
+		{"text", []byte("hello"), "aGVsbG8=", "aGVsbG8", "aGVsbG8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Base64Encode(tt.data); got != tt.std {
+				t.Errorf("Base64Encode = %q, want %q", got, tt.std)
+			}
+			if got := Base64UrlEncode(tt.data); got != tt.url {
+				t.Errorf("Base64UrlEncode = %q, want %q", got, tt.url)
+			}
+			if got, err := Base64Decode(tt.std); err != nil || !bytes.Equal(got, tt.data) {
+				t.Errorf("Base64Decode(%q) = %v, %v", tt.std, got, err)
+			}
+			if got, err := Base64UrlDecode(tt.urlText); err != nil || !bytes.Equal(got, tt.data) {
+				t.Errorf("Base64UrlDecode(%q) = %v, %v", tt.urlText, got, err)
+			}
+		})
+	}
+	if _, err := Base64Decode("!!"); err == nil {
+		t.Error("Base64Decode of invalid text must fail")
+	}
+}
+
+func TestHex(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    []byte
+		wantErr bool
+	}{
+		{"0a0b", []byte{0x0a, 0x0b}, false},
+		{"0x0A0B", []byte{0x0a, 0x0b}, false},
+		{"0xz", nil, true},
+		{"abc", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := HexDecode(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HexDecode(%q) error = %v, wantErr %v", tt.text, err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("HexDecode(%q) = %x, want %x", tt.text, got, tt.want)
+			}
+		})
+	}
+	if got := HexEncode([]byte{0x0a, 0xff}); got != "0aff" {
+		t.Errorf("HexEncode = %s, want 0aff", got)
+	}
+}
+
+func TestJson(t *testing.T) {
+	type tItem struct {
+		Name string `json:"name"`
+	}
+	data, err := JsonEncode(tItem{Name: "a"})
+	if err != nil || string(data) != `{"name":"a"}` {
+		t.Fatalf("JsonEncode = %s, %v", data, err)
+	}
+	var item tItem
+	if err := JsonDecode(data, &item); err != nil || item.Name != "a" {
+		t.Fatalf("JsonDecode = %+v, %v", item, err)
+	}
+	if err := JsonDecode([]byte("{"), &item); err == nil {
+		t.Error("JsonDecode of invalid json must fail")
+	}
+	if got := JsonEncodeF(make(chan int)); got[:7] != "<json: " {
+		t.Errorf("JsonEncodeF renders %q for an unsupported value", got)
+	}
+}
@@ -0,0 +1,7 @@
+package utils
+
+func PanicOnError(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
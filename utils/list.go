@@ -0,0 +1,60 @@
+package utils
+
+import "github.com/kiyuu10/common-lib-go/types"
+
+func ListMap[T any, R any](items []T, mapper func(i int, item T) R) []R {
+	results := make([]R, len(items))
+	for i, item := range items {
+		results[i] = mapper(i, item)
+	}
+	return results
+}
+
+func ListFilter[T any](items []T, filter func(i int, item T) bool) []T {
+	results := make([]T, 0, len(items))
+	for i, item := range items {
+		if filter(i, item) {
+			results = append(results, item)
+		}
+	}
+	return results
+}
+
+func ListToMap[T any, K comparable, V any](items []T, mapper func(i int, item T) (K, V)) map[K]V {
+	results := make(map[K]V, len(items))
+	for i, item := range items {
+		key, value := mapper(i, item)
+		results[key] = value
+	}
+	return results
+}
+
+// ListToMapFlat allows one item to produce many map entries, the later entries override the former ones.
+func ListToMapFlat[T any, K comparable, V any](
+	items []T,
+	mapper func(i int, item T) []types.KeyValue[K, V],
+) map[K]V {
+	results := make(map[K]V, len(items))
+	for i, item := range items {
+		for _, kv := range mapper(i, item) {
+			results[kv.Key] = kv.Value
+		}
+	}
+	return results
+}
+
+func MapKeys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func MapValues[K comparable, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+	for _, value := range m {
+		values = append(values, value)
+	}
+	return values
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/kiyuu10/common-lib-go/types"
+)
+
+func TestListMap(t *testing.T) {
+	got := ListMap([]int{1, 2, 3}, func(i int, item int) string {
+		return strconv.Itoa(i) + ":" + strconv.Itoa(item*10)
+	})
+	if want := []string{"0:10", "1:20", "2:30"}; !slices.Equal(got, want) {
+		t.Errorf("ListMap = %v, want %v", got, want)
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []int
+		want  []int
+	}{
+		{"nil", nil, []int{}},
+		{"even", []int{1, 2, 3, 4}, []int{2, 4}},
+		{"none", []int{1, 3}, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ListFilter(tt.items, func(_ int, item int) bool { return item%2 == 0 })
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ListFilter(%v) = %v, want %v", tt.items, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListToMap(t *testing.T) {
+	got := ListToMap([]string{"a", "bb", "cc"}, func(_ int, item string) (int, string) {
+		return len(item), item
+	})
+	if len(got) != 2 || got[1] != "a" || got[2] != "cc" {
+		t.Errorf("ListToMap = %v, later items must override former ones", got)
+	}
+
+	gotFlat := ListToMapFlat([]string{"a", "b"}, func(i int, item string) []types.KeyValue[string, int] {
+		return []types.KeyValue[string, int]{{Key: item, Value: i}, {Key: item + item, Value: i}}
+	})
+	if len(gotFlat) != 4 || gotFlat["bb"] != 1 {
+		t.Errorf("ListToMapFlat = %v", gotFlat)
+	}
+}
+
+func TestMapKeysValues(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	keys, values := MapKeys(m), MapValues(m)
+	slices.Sort(keys)
+	slices.Sort(values)
+	if !slices.Equal(keys, []string{"a", "b"}) || !slices.Equal(values, []int{1, 2}) {
+		t.Errorf("MapKeys = %v, MapValues = %v", keys, values)
+	}
+}
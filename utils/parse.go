@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/kiyuu10/common-lib-go/erroy"
+)
+
+func ParseUint64(text string) (uint64, error) {
+	value, err := strconv.ParseUint(strings.TrimSpace(text), 10, 64)
+	if err != nil {
+		return 0, erroy.WrapStack(err, "parse uint64").WithField("text", text)
+	}
+	return value, nil
+}
+
+func ParseUint64F(text string) uint64 {
+	value, err := ParseUint64(text)
+	PanicOnError(err)
+	return value
+}
+
+func ParseInt64(text string) (int64, error) {
+	value, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+	if err != nil {
+		return 0, erroy.WrapStack(err, "parse int64").WithField("text", text)
+	}
+	return value, nil
+}
+
+func ParseInt64F(text string) int64 {
+	value, err := ParseInt64(text)
+	PanicOnError(err)
+	return value
+}
+
+func ParseBool(text string) (bool, error) {
+	value, err := strconv.ParseBool(strings.TrimSpace(text))
+	if err != nil {
+		return false, erroy.WrapStack(err, "parse bool").WithField("text", text)
+	}
+	return value, nil
+}
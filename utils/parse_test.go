@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestParseUint64(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    uint64
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{" 42 ", 42, false},
+		{"18446744073709551615", 18446744073709551615, false},
+		{"-1", 0, true},
+		{"1.5", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseUint64(tt.text)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseUint64(%q) = %d, %v", tt.text, got, err)
+			}
+		})
+	}
+}
+
+func TestParseInt64(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    int64
+		wantErr bool
+	}{
+		{"-42", -42, false},
+		{"\t7\n", 7, false},
+		{"9223372036854775808", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseInt64(tt.text)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseInt64(%q) = %d, %v", tt.text, got, err)
+			}
+		})
+	}
+}
+
+func TestParseBool(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    bool
+		wantErr bool
+	}{
+		{"true", true, false},
+		{" 0 ", false, false},
+		{"yes", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseBool(tt.text)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseBool(%q) = %v, %v", tt.text, got, err)
+			}
+		})
+	}
+}
+
+func TestParseF(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("ParseInt64F must panic on invalid text")
+		}
+	}()
+	ParseInt64F("x")
+}
@@ -0,0 +1,20 @@
+package utils
+
+import "time"
+
+func TimeNow() time.Time {
+	return time.Now().UTC()
+}
+
+func TimeUnix(seconds int64) time.Time {
+	return time.Unix(seconds, 0).UTC()
+}
+
+func TimeUnixMilli(milliseconds int64) time.Time {
+	return time.UnixMilli(milliseconds).UTC()
+}
+
+func TimeStartOfDay(tm time.Time) time.Time {
+	year, month, day := tm.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, tm.Location())
+}
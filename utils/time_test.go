@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeUnix(t *testing.T) {
+	tests := []struct {
+		name string
+		got  time.Time
+		want time.Time
+	}{
+		{"seconds", TimeUnix(86400), time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"milliseconds", TimeUnixMilli(1500), time.Date(1970, 1, 1, 0, 0, 1, 5e8, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) || tt.got.Location() != time.UTC {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+	if TimeNow().Location() != time.UTC {
+		t.Error("TimeNow isn't in UTC")
+	}
+}
+
+func TestTimeStartOfDay(t *testing.T) {
+	location := time.FixedZone("UTC+7", 7*3600)
+	tests := []struct {
+		name string
+		tm   time.Time
+		want time.Time
+	}{
+		{"utc", time.Date(2024, 2, 29, 23, 59, 59, 1, time.UTC), time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"keeps location", time.Date(2024, 3, 1, 1, 0, 0, 0, location), time.Date(2024, 3, 1, 0, 0, 0, 0, location)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TimeStartOfDay(tt.tm); !got.Equal(tt.want) {
+				t.Errorf("TimeStartOfDay(%v) = %v, want %v", tt.tm, got, tt.want)
+			}
+		})
+	}
+}